@@ -0,0 +1,264 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cache provides read-through caching decorators of the core-metadata clients, which serve the lookups by name
+// from memory until their time-to-live expires or a system event reports that the entity changed.
+//
+// Each lookup returns a copy of the cached response, which the caller may modify.
+package cache
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// Invalidator is implemented by the caching clients, which evict the entries affected by a system event
+type Invalidator interface {
+	// Invalidate evicts the cached entries of the entity the system event is about. Events of other types are ignored.
+	Invalidate(event dtos.SystemEvent)
+}
+
+// ttlCache caches the responses and the NotFound errors of the lookups by key
+type ttlCache struct {
+	ttl         time.Duration
+	notFoundTTL time.Duration
+	mutex       sync.Mutex
+	entries     map[string]cacheEntry
+	// loads tracks the keys being loaded and epoch counts the clears, so that a response loaded while its key is
+	// invalidated is not cached. A key is tracked only until its last load completes.
+	loads map[string]*pendingLoads
+	epoch uint64
+	now   func() time.Time
+}
+
+// pendingLoads counts the loads in progress of a key and the invalidations of the key since the first of them started
+type pendingLoads struct {
+	count      int
+	generation uint64
+}
+
+// generation identifies the invalidations a key went through
+type generation struct {
+	epoch uint64
+	key   uint64
+}
+
+type cacheEntry struct {
+	value     interface{}
+	err       errors.EdgeX
+	expiresAt time.Time
+}
+
+// newTTLCache creates a cache keeping the responses for ttl and the NotFound errors for notFoundTTL.
+// A notFoundTTL <= 0 disables the caching of NotFound errors.
+func newTTLCache(ttl time.Duration, notFoundTTL time.Duration) *ttlCache {
+	return &ttlCache{
+		ttl:         ttl,
+		notFoundTTL: notFoundTTL,
+		entries:     make(map[string]cacheEntry),
+		loads:       make(map[string]*pendingLoads),
+		now:         time.Now,
+	}
+}
+
+// get returns a copy of the cached response of the key, or calls load and caches its response or NotFound error.
+// The response is not cached when the key is invalidated while load runs.
+func (c *ttlCache) get(key string, load func() (interface{}, errors.EdgeX)) (interface{}, errors.EdgeX) {
+	c.mutex.Lock()
+	entry, ok := c.entries[key]
+	if ok && c.now().Before(entry.expiresAt) {
+		c.mutex.Unlock()
+		if entry.err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(entry.err)
+		}
+		return deepCopy(entry.value), nil
+	}
+	// The entry expired, if any
+	delete(c.entries, key)
+	loadedGeneration := c.startLoad(key)
+	c.mutex.Unlock()
+
+	value, err := load()
+	var ttl time.Duration
+	if err == nil {
+		ttl = c.ttl
+	} else if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		ttl = c.notFoundTTL
+	}
+
+	c.mutex.Lock()
+	if c.endLoad(key) == loadedGeneration {
+		if ttl > 0 {
+			c.entries[key] = cacheEntry{value: value, err: err, expiresAt: c.now().Add(ttl)}
+		} else {
+			delete(c.entries, key)
+		}
+	}
+	c.mutex.Unlock()
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return deepCopy(value), nil
+}
+
+// startLoad tracks a load of the key and returns the generation of the key when it starts. It must be called with the
+// mutex locked.
+func (c *ttlCache) startLoad(key string) generation {
+	pending, ok := c.loads[key]
+	if !ok {
+		pending = &pendingLoads{}
+		c.loads[key] = pending
+	}
+	pending.count++
+	return generation{epoch: c.epoch, key: pending.generation}
+}
+
+// endLoad stops tracking a load of the key started by startLoad and returns the generation of the key when it
+// completes. It must be called with the mutex locked.
+func (c *ttlCache) endLoad(key string) generation {
+	pending := c.loads[key]
+	pending.count--
+	if pending.count == 0 {
+		delete(c.loads, key)
+	}
+	return generation{epoch: c.epoch, key: pending.generation}
+}
+
+// invalidate evicts the entries of the keys
+func (c *ttlCache) invalidate(keys ...string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, key := range keys {
+		delete(c.entries, key)
+		if pending, ok := c.loads[key]; ok {
+			pending.generation++
+		}
+	}
+}
+
+// invalidatePrefix evicts the entries whose key starts with the prefix
+func (c *ttlCache) invalidatePrefix(prefix string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.entries, key)
+		}
+	}
+	for key, pending := range c.loads {
+		if strings.HasPrefix(key, prefix) {
+			pending.generation++
+		}
+	}
+}
+
+// clear evicts all the entries
+func (c *ttlCache) clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries = make(map[string]cacheEntry)
+	// The loads in progress keep being tracked, their responses being discarded as the epoch changes
+	c.epoch++
+}
+
+// deepCopy returns a copy of the response sharing no slice, map or pointer with it
+func deepCopy(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	return copyValue(reflect.ValueOf(value)).Interface()
+}
+
+func copyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(copyValue(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(copyValue(v.Elem()))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), copyValue(iter.Value()))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(copyValue(v.Field(i)))
+			}
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return c
+	}
+	return v
+}
+
+// systemEventName returns the name of the entity the system event is about, empty if the details carry no name
+func systemEventName(event dtos.SystemEvent) string {
+	var details struct {
+		Name string `json:"name"`
+	}
+	if err := event.DecodeDetails(&details); err != nil {
+		return ""
+	}
+	return details.Name
+}
+
+// invalidatingAction reports whether the action of the system event changes the entity it is about
+func invalidatingAction(action string) bool {
+	switch action {
+	case common.SystemEventActionAdd, common.SystemEventActionUpdate, common.SystemEventActionDelete:
+		return true
+	}
+	return false
+}
+
+// updatedNames returns the names of the entities updated by the requests, false if one of them is only identified by id
+func updatedNames(names []*string) ([]string, bool) {
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		if name == nil || *name == "" {
+			return nil, false
+		}
+		result = append(result, *name)
+	}
+	return result, true
+}
@@ -0,0 +1,219 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+const (
+	TestDeviceName  = "Random-Integer-Device"
+	TestProfileName = "Random-Integer-Profile"
+)
+
+// testClock is a manually advanced clock replacing time.Now in the caches
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestClock(caches ...*ttlCache) *testClock {
+	clock := &testClock{now: time.Now()}
+	for _, cache := range caches {
+		cache.now = clock.Now
+	}
+	return clock
+}
+
+func TestTTLCache(t *testing.T) {
+	cache := newTTLCache(time.Minute, time.Second)
+	clock := newTestClock(cache)
+
+	loads := 0
+	loadValue := func() (interface{}, errors.EdgeX) {
+		loads++
+		return loads, nil
+	}
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+	loadNotFound := func() (interface{}, errors.EdgeX) {
+		loads++
+		return nil, notFound
+	}
+	serverError := errors.NewCommonEdgeX(errors.KindServerError, "server error", nil)
+	loadServerError := func() (interface{}, errors.EdgeX) {
+		loads++
+		return nil, serverError
+	}
+
+	value, err := cache.get("a", loadValue)
+	require.NoError(t, err)
+	assert.Equal(t, 1, value)
+	value, err = cache.get("a", loadValue)
+	require.NoError(t, err)
+	assert.Equal(t, 1, value, "the value should be cached until the TTL expires")
+	clock.advance(time.Minute)
+	value, err = cache.get("a", loadValue)
+	require.NoError(t, err)
+	assert.Equal(t, 2, value)
+
+	_, err = cache.get("b", loadNotFound)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	_, err = cache.get("b", loadValue)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err), "the NotFound error should be cached")
+	clock.advance(time.Second)
+	value, err = cache.get("b", loadValue)
+	require.NoError(t, err)
+	assert.Equal(t, 4, value)
+
+	_, err = cache.get("c", loadServerError)
+	assert.Equal(t, errors.KindServerError, errors.Kind(err))
+	value, err = cache.get("c", loadValue)
+	require.NoError(t, err)
+	assert.Equal(t, 6, value, "other errors should not be cached")
+
+	cache.invalidate("a")
+	value, _ = cache.get("a", loadValue)
+	assert.Equal(t, 7, value)
+	cache.invalidatePrefix("b")
+	value, _ = cache.get("b", loadValue)
+	assert.Equal(t, 8, value)
+	cache.clear()
+	value, _ = cache.get("c", loadValue)
+	assert.Equal(t, 9, value)
+}
+
+func TestTTLCacheWithoutNegativeCaching(t *testing.T) {
+	cache := newTTLCache(time.Minute, 0)
+	_, err := cache.get("a", func() (interface{}, errors.EdgeX) {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+	})
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	value, err := cache.get("a", func() (interface{}, errors.EdgeX) {
+		return 1, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, value)
+}
+
+func TestSystemEventName(t *testing.T) {
+	device := dtos.Device{Name: TestDeviceName}
+	assert.Equal(t, TestDeviceName, systemEventName(dtos.NewSystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, "core-metadata", "", nil, device)))
+	assert.Equal(t, TestDeviceName, systemEventName(dtos.NewSystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, "core-metadata", "", nil, map[string]interface{}{"name": TestDeviceName})))
+	assert.Empty(t, systemEventName(dtos.NewSystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, "core-metadata", "", nil, nil)))
+	assert.Empty(t, systemEventName(dtos.NewSystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, "core-metadata", "", nil, "invalid")))
+}
+
+func TestTTLCacheInvalidatedDuringLoad(t *testing.T) {
+	tests := []struct {
+		name       string
+		invalidate func(cache *ttlCache)
+	}{
+		{"invalidate", func(cache *ttlCache) { cache.invalidate("a") }},
+		{"invalidate prefix", func(cache *ttlCache) { cache.invalidatePrefix("a") }},
+		{"clear", func(cache *ttlCache) { cache.clear() }},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cache := newTTLCache(time.Minute, time.Minute)
+			value, err := cache.get("a", func() (interface{}, errors.EdgeX) {
+				testCase.invalidate(cache)
+				return 1, nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, 1, value)
+
+			value, err = cache.get("a", func() (interface{}, errors.EdgeX) {
+				return 2, nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, 2, value, "the value loaded while the key was invalidated should not be cached")
+			value, err = cache.get("a", func() (interface{}, errors.EdgeX) {
+				return 3, nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, 2, value)
+		})
+	}
+}
+
+func TestTTLCacheTracksOnlyLoadingKeys(t *testing.T) {
+	cache := newTTLCache(time.Minute, 0)
+	clock := newTestClock(cache)
+
+	keys := []string{"a", "b", "c"}
+	for _, key := range keys {
+		_, _ = cache.get(key, func() (interface{}, errors.EdgeX) {
+			assert.Len(t, cache.loads, 1, "the key should be tracked while it is loaded")
+			return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+		})
+	}
+	assert.Empty(t, cache.loads, "the keys should not be tracked once loaded")
+	assert.Empty(t, cache.entries)
+
+	_, err := cache.get("a", func() (interface{}, errors.EdgeX) {
+		return 1, nil
+	})
+	require.NoError(t, err)
+	cache.invalidate(keys...)
+	cache.invalidatePrefix("")
+	assert.Empty(t, cache.loads)
+	assert.Empty(t, cache.entries)
+
+	// The expired entry is evicted when it is looked up
+	_, err = cache.get("a", func() (interface{}, errors.EdgeX) {
+		return 1, nil
+	})
+	require.NoError(t, err)
+	clock.advance(time.Minute)
+	_, err = cache.get("a", func() (interface{}, errors.EdgeX) {
+		assert.NotContains(t, cache.entries, "a")
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "server error", nil)
+	})
+	require.Error(t, err)
+	assert.Empty(t, cache.entries)
+	assert.Empty(t, cache.loads)
+}
+
+func TestTTLCacheReturnsCopies(t *testing.T) {
+	type response struct {
+		Labels     []string
+		Tags       map[string]interface{}
+		Properties *map[string]string
+	}
+	properties := map[string]string{"Address": "10.0.0.1"}
+	cache := newTTLCache(time.Minute, time.Minute)
+	load := func() (interface{}, errors.EdgeX) {
+		return response{Labels: []string{"floor-1"}, Tags: map[string]interface{}{"site": []interface{}{"A"}}, Properties: &properties}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		value, err := cache.get("a", load)
+		require.NoError(t, err)
+		res := value.(response)
+		assert.Equal(t, []string{"floor-1"}, res.Labels)
+		assert.Equal(t, map[string]interface{}{"site": []interface{}{"A"}}, res.Tags)
+		assert.Equal(t, map[string]string{"Address": "10.0.0.1"}, *res.Properties)
+		res.Labels[0] = "modified"
+		res.Tags["site"].([]interface{})[0] = "modified"
+		(*res.Properties)["Address"] = "modified"
+	}
+	assert.Equal(t, map[string]string{"Address": "10.0.0.1"}, properties)
+}
@@ -0,0 +1,215 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+const TestEntityName = "Random-Entity"
+
+var testLabels = []string{"floor-1", "outdoor"}
+
+// testDecorator adapts a caching client to the lookup by name and the mutations shared by all the decorators. The
+// decorated mock client answers the lookup of TestEntityName with testLabels and reports the other names as not found.
+type testDecorator struct {
+	mock         *mock.Mock
+	method       string
+	lookUp       func(name string) ([]string, errors.EdgeX)
+	add          func(name string)
+	deleteByName func(name string)
+	deleteById   func()
+	invalidator  Invalidator
+	eventType    string
+	details      func(name string) interface{}
+}
+
+var notFound = errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+
+func newTestDeviceDecorator() testDecorator {
+	mockClient := &mocks.DeviceClient{}
+	mockClient.On("DeviceByName", mock.Anything, TestEntityName).
+		Return(responses.DeviceResponse{Device: dtos.Device{Name: TestEntityName, Labels: testLabels}}, nil)
+	mockClient.On("DeviceByName", mock.Anything, mock.Anything).Return(responses.DeviceResponse{}, notFound)
+	mockClient.On("Add", mock.Anything, mock.Anything).Return([]dtoCommon.BaseWithIdResponse{}, nil)
+	mockClient.On("DeleteDeviceByName", mock.Anything, mock.Anything).Return(dtoCommon.BaseResponse{}, nil)
+	mockClient.On("DeleteDeviceById", mock.Anything, mock.Anything).Return(dtoCommon.BaseResponse{}, nil)
+	client := NewDeviceClient(mockClient, time.Minute, time.Minute)
+	return testDecorator{
+		mock:   &mockClient.Mock,
+		method: "DeviceByName",
+		lookUp: func(name string) ([]string, errors.EdgeX) {
+			res, err := client.DeviceByName(context.Background(), name)
+			return res.Device.Labels, err
+		},
+		add: func(name string) {
+			_, _ = client.Add(context.Background(), []requests.AddDeviceRequest{{Device: dtos.Device{Name: name}}})
+		},
+		deleteByName: func(name string) { _, _ = client.DeleteDeviceByName(context.Background(), name) },
+		deleteById:   func() { _, _ = client.DeleteDeviceById(context.Background(), "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc") },
+		invalidator:  client,
+		eventType:    common.DeviceSystemEventType,
+		details:      func(name string) interface{} { return dtos.Device{Name: name} },
+	}
+}
+
+func newTestDeviceProfileDecorator() testDecorator {
+	mockClient := &mocks.DeviceProfileClient{}
+	mockClient.On("DeviceProfileByName", mock.Anything, TestEntityName).Return(responses.DeviceProfileResponse{
+		Profile: dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: TestEntityName, Labels: testLabels}}}, nil)
+	mockClient.On("DeviceProfileByName", mock.Anything, mock.Anything).Return(responses.DeviceProfileResponse{}, notFound)
+	mockClient.On("Add", mock.Anything, mock.Anything).Return([]dtoCommon.BaseWithIdResponse{}, nil)
+	mockClient.On("DeleteByName", mock.Anything, mock.Anything).Return(dtoCommon.BaseResponse{}, nil)
+	mockClient.On("DeleteById", mock.Anything, mock.Anything).Return(dtoCommon.BaseResponse{}, nil)
+	client := NewDeviceProfileClient(mockClient, time.Minute, time.Minute)
+	return testDecorator{
+		mock:   &mockClient.Mock,
+		method: "DeviceProfileByName",
+		lookUp: func(name string) ([]string, errors.EdgeX) {
+			res, err := client.DeviceProfileByName(context.Background(), name)
+			return res.Profile.Labels, err
+		},
+		add: func(name string) {
+			_, _ = client.Add(context.Background(), []requests.DeviceProfileRequest{
+				{Profile: dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: name}}}})
+		},
+		deleteByName: func(name string) { _, _ = client.DeleteByName(context.Background(), name) },
+		deleteById:   func() { _, _ = client.DeleteById(context.Background(), "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc") },
+		invalidator:  client,
+		eventType:    common.DeviceProfileSystemEventType,
+		details: func(name string) interface{} {
+			return dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: name}}
+		},
+	}
+}
+
+func newTestDeviceServiceDecorator() testDecorator {
+	mockClient := &mocks.DeviceServiceClient{}
+	mockClient.On("DeviceServiceByName", mock.Anything, TestEntityName).
+		Return(responses.DeviceServiceResponse{Service: dtos.DeviceService{Name: TestEntityName, Labels: testLabels}}, nil)
+	mockClient.On("DeviceServiceByName", mock.Anything, mock.Anything).Return(responses.DeviceServiceResponse{}, notFound)
+	mockClient.On("Add", mock.Anything, mock.Anything).Return([]dtoCommon.BaseWithIdResponse{}, nil)
+	mockClient.On("DeleteByName", mock.Anything, mock.Anything).Return(dtoCommon.BaseResponse{}, nil)
+	mockClient.On("DeleteById", mock.Anything, mock.Anything).Return(dtoCommon.BaseResponse{}, nil)
+	client := NewDeviceServiceClient(mockClient, time.Minute, time.Minute)
+	return testDecorator{
+		mock:   &mockClient.Mock,
+		method: "DeviceServiceByName",
+		lookUp: func(name string) ([]string, errors.EdgeX) {
+			res, err := client.DeviceServiceByName(context.Background(), name)
+			return res.Service.Labels, err
+		},
+		add: func(name string) {
+			_, _ = client.Add(context.Background(), []requests.AddDeviceServiceRequest{{Service: dtos.DeviceService{Name: name}}})
+		},
+		deleteByName: func(name string) { _, _ = client.DeleteByName(context.Background(), name) },
+		deleteById:   func() { _, _ = client.DeleteById(context.Background(), "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc") },
+		invalidator:  client,
+		eventType:    common.DeviceServiceSystemEventType,
+		details:      func(name string) interface{} { return dtos.DeviceService{Name: name} },
+	}
+}
+
+func newTestProvisionWatcherDecorator() testDecorator {
+	mockClient := &mocks.ProvisionWatcherClient{}
+	mockClient.On("ProvisionWatcherByName", mock.Anything, TestEntityName).
+		Return(responses.ProvisionWatcherResponse{ProvisionWatcher: dtos.ProvisionWatcher{Name: TestEntityName, Labels: testLabels}}, nil)
+	mockClient.On("ProvisionWatcherByName", mock.Anything, mock.Anything).Return(responses.ProvisionWatcherResponse{}, notFound)
+	mockClient.On("Add", mock.Anything, mock.Anything).Return([]dtoCommon.BaseWithIdResponse{}, nil)
+	mockClient.On("DeleteProvisionWatcherByName", mock.Anything, mock.Anything).Return(dtoCommon.BaseResponse{}, nil)
+	mockClient.On("DeleteProvisionWatcherById", mock.Anything, mock.Anything).Return(dtoCommon.BaseResponse{}, nil)
+	client := NewProvisionWatcherClient(mockClient, time.Minute, time.Minute)
+	return testDecorator{
+		mock:   &mockClient.Mock,
+		method: "ProvisionWatcherByName",
+		lookUp: func(name string) ([]string, errors.EdgeX) {
+			res, err := client.ProvisionWatcherByName(context.Background(), name)
+			return res.ProvisionWatcher.Labels, err
+		},
+		add: func(name string) {
+			_, _ = client.Add(context.Background(), []requests.AddProvisionWatcherRequest{{ProvisionWatcher: dtos.ProvisionWatcher{Name: name}}})
+		},
+		deleteByName: func(name string) { _, _ = client.DeleteProvisionWatcherByName(context.Background(), name) },
+		deleteById: func() {
+			_, _ = client.DeleteProvisionWatcherById(context.Background(), "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc")
+		},
+		invalidator: client,
+		eventType:   common.ProvisionWatcherSystemEventType,
+		details:     func(name string) interface{} { return dtos.ProvisionWatcher{Name: name} },
+	}
+}
+
+func TestDecorators(t *testing.T) {
+	decorators := []struct {
+		name         string
+		newDecorator func() testDecorator
+	}{
+		{"DeviceClient", newTestDeviceDecorator},
+		{"DeviceProfileClient", newTestDeviceProfileDecorator},
+		{"DeviceServiceClient", newTestDeviceServiceDecorator},
+		{"ProvisionWatcherClient", newTestProvisionWatcherDecorator},
+	}
+	for _, decorator := range decorators {
+		t.Run(decorator.name, func(t *testing.T) {
+			d := decorator.newDecorator()
+			// lookUp looks up TestEntityName and an unknown name twice, which should reach the decorated client at most
+			// once each
+			lookUp := func() {
+				for i := 0; i < 2; i++ {
+					labels, err := d.lookUp(TestEntityName)
+					require.NoError(t, err)
+					assert.Equal(t, testLabels, labels)
+					_, err = d.lookUp("unknown")
+					assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+				}
+			}
+
+			lookUp()
+			d.mock.AssertNumberOfCalls(t, d.method, 2)
+
+			labels, err := d.lookUp(TestEntityName)
+			require.NoError(t, err)
+			labels[0] = "modified"
+			lookUp()
+			d.mock.AssertNumberOfCalls(t, d.method, 2)
+
+			d.add("unknown")
+			lookUp()
+			d.mock.AssertNumberOfCalls(t, d.method, 3)
+
+			d.deleteByName(TestEntityName)
+			lookUp()
+			d.mock.AssertNumberOfCalls(t, d.method, 4)
+
+			d.invalidator.Invalidate(dtos.NewSystemEvent(d.eventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, "", nil, d.details(TestEntityName)))
+			lookUp()
+			d.mock.AssertNumberOfCalls(t, d.method, 5)
+
+			d.invalidator.Invalidate(dtos.NewSystemEvent("other", common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, "", nil, d.details(TestEntityName)))
+			lookUp()
+			d.mock.AssertNumberOfCalls(t, d.method, 5)
+
+			// Deleting by id clears the cache, which is keyed by name
+			d.deleteById()
+			lookUp()
+			d.mock.AssertNumberOfCalls(t, d.method, 7)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// DeviceClient caches the devices returned by DeviceByName, the other methods being passed to the decorated client.
+// The devices changed through this client are evicted from the cache.
+type DeviceClient struct {
+	interfaces.DeviceClient
+	cache *ttlCache
+}
+
+// NewDeviceClient creates a DeviceClient caching the devices for ttl and the NotFound errors for notFoundTTL.
+// A notFoundTTL <= 0 disables the caching of NotFound errors.
+func NewDeviceClient(client interfaces.DeviceClient, ttl time.Duration, notFoundTTL time.Duration) *DeviceClient {
+	return &DeviceClient{
+		DeviceClient: client,
+		cache:        newTTLCache(ttl, notFoundTTL),
+	}
+}
+
+func (dc *DeviceClient) DeviceByName(ctx context.Context, name string) (responses.DeviceResponse, errors.EdgeX) {
+	res, err := dc.cache.get(name, func() (interface{}, errors.EdgeX) {
+		return dc.DeviceClient.DeviceByName(ctx, name)
+	})
+	if err != nil {
+		return responses.DeviceResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res.(responses.DeviceResponse), nil
+}
+
+func (dc *DeviceClient) Add(ctx context.Context, reqs []requests.AddDeviceRequest) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	res, err := dc.DeviceClient.Add(ctx, reqs)
+	// Evict the NotFound errors of the added devices
+	for _, req := range reqs {
+		dc.cache.invalidate(req.Device.Name)
+	}
+	return res, err
+}
+
+func (dc *DeviceClient) Update(ctx context.Context, reqs []requests.UpdateDeviceRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dc.DeviceClient.Update(ctx, reqs)
+	names := make([]*string, len(reqs))
+	for i, req := range reqs {
+		names[i] = req.Device.Name
+	}
+	if updated, ok := updatedNames(names); ok {
+		dc.cache.invalidate(updated...)
+	} else {
+		dc.cache.clear()
+	}
+	return res, err
+}
+
+func (dc *DeviceClient) DeleteDeviceByName(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dc.DeviceClient.DeleteDeviceByName(ctx, name)
+	dc.cache.invalidate(name)
+	return res, err
+}
+
+// DeleteDeviceById clears the cache, which is keyed by device name
+func (dc *DeviceClient) DeleteDeviceById(ctx context.Context, id string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dc.DeviceClient.DeleteDeviceById(ctx, id)
+	dc.cache.clear()
+	return res, err
+}
+
+// Invalidate evicts the device the device system event is about, or all the devices if the event carries no device name
+func (dc *DeviceClient) Invalidate(event dtos.SystemEvent) {
+	if event.Type != common.DeviceSystemEventType || !invalidatingAction(event.Action) {
+		return
+	}
+	if name := systemEventName(event); name != "" {
+		dc.cache.invalidate(name)
+		return
+	}
+	dc.cache.clear()
+}
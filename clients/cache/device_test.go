@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+func TestDeviceClient_DeviceByName(t *testing.T) {
+	expected := responses.DeviceResponse{Device: dtos.Device{Name: TestDeviceName}}
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil)
+	mockClient := &mocks.DeviceClient{}
+	mockClient.On("DeviceByName", mock.Anything, TestDeviceName).Return(expected, nil).Once()
+	mockClient.On("DeviceByName", mock.Anything, "unknown").Return(responses.DeviceResponse{}, notFound).Once()
+	client := NewDeviceClient(mockClient, time.Minute, time.Second)
+	clock := newTestClock(client.cache)
+
+	for i := 0; i < 2; i++ {
+		res, err := client.DeviceByName(context.Background(), TestDeviceName)
+		require.NoError(t, err)
+		assert.Equal(t, expected, res)
+		_, err = client.DeviceByName(context.Background(), "unknown")
+		assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	}
+	mockClient.AssertExpectations(t)
+
+	// The NotFound error expires before the device
+	clock.advance(time.Second)
+	mockClient.On("DeviceByName", mock.Anything, "unknown").Return(expected, nil).Once()
+	_, err := client.DeviceByName(context.Background(), "unknown")
+	require.NoError(t, err)
+	_, err = client.DeviceByName(context.Background(), TestDeviceName)
+	require.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestDeviceClient_Mutations(t *testing.T) {
+	expected := responses.DeviceResponse{Device: dtos.Device{Name: TestDeviceName}}
+	mockClient := &mocks.DeviceClient{}
+	mockClient.On("DeviceByName", mock.Anything, TestDeviceName).Return(expected, nil)
+	mockClient.On("Add", mock.Anything, mock.Anything).Return([]dtoCommon.BaseWithIdResponse{}, nil)
+	mockClient.On("Update", mock.Anything, mock.Anything).Return([]dtoCommon.BaseResponse{}, nil)
+	mockClient.On("DeleteDeviceByName", mock.Anything, TestDeviceName).Return(dtoCommon.BaseResponse{}, nil)
+	mockClient.On("DeleteDeviceById", mock.Anything, mock.Anything).Return(dtoCommon.BaseResponse{}, nil)
+	client := NewDeviceClient(mockClient, time.Minute, time.Minute)
+
+	name := TestDeviceName
+	id := "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc"
+	mutations := []func(){
+		func() {
+			_, _ = client.Add(context.Background(), []requests.AddDeviceRequest{{Device: dtos.Device{Name: TestDeviceName}}})
+		},
+		func() {
+			_, _ = client.Update(context.Background(), []requests.UpdateDeviceRequest{{Device: dtos.UpdateDevice{Name: &name}}})
+		},
+		func() {
+			_, _ = client.Update(context.Background(), []requests.UpdateDeviceRequest{{Device: dtos.UpdateDevice{Id: &id}}})
+		},
+		func() {
+			_, _ = client.DeleteDeviceByName(context.Background(), TestDeviceName)
+		},
+		func() {
+			_, _ = client.DeleteDeviceById(context.Background(), id)
+		},
+	}
+	_, err := client.DeviceByName(context.Background(), TestDeviceName)
+	require.NoError(t, err)
+	for _, mutate := range mutations {
+		mutate()
+		_, err = client.DeviceByName(context.Background(), TestDeviceName)
+		require.NoError(t, err)
+	}
+	mockClient.AssertNumberOfCalls(t, "DeviceByName", len(mutations)+1)
+}
+
+func TestDeviceClient_Invalidate(t *testing.T) {
+	device := dtos.Device{Name: TestDeviceName}
+	tests := []struct {
+		name        string
+		event       dtos.SystemEvent
+		invalidated bool
+	}{
+		{"add", dtos.NewSystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, common.CoreMetaDataServiceKey, "", nil, device), true},
+		{"update", dtos.NewSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, "", nil, device), true},
+		{"delete", dtos.NewSystemEvent(common.DeviceSystemEventType, common.SystemEventActionDelete, common.CoreMetaDataServiceKey, "", nil, device), true},
+		{"no device name", dtos.NewSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, "", nil, nil), true},
+		{"other device", dtos.NewSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, "", nil, dtos.Device{Name: "other"}), false},
+		{"other type", dtos.NewSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, "", nil, device), false},
+		{"unknown action", dtos.NewSystemEvent(common.DeviceSystemEventType, "unknown", common.CoreMetaDataServiceKey, "", nil, device), false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockClient := &mocks.DeviceClient{}
+			mockClient.On("DeviceByName", mock.Anything, TestDeviceName).Return(responses.DeviceResponse{Device: device}, nil)
+			client := NewDeviceClient(mockClient, time.Minute, 0)
+			var _ Invalidator = client
+
+			_, err := client.DeviceByName(context.Background(), TestDeviceName)
+			require.NoError(t, err)
+			client.Invalidate(testCase.event)
+			_, err = client.DeviceByName(context.Background(), TestDeviceName)
+			require.NoError(t, err)
+
+			expectedCalls := 1
+			if testCase.invalidated {
+				expectedCalls = 2
+			}
+			mockClient.AssertNumberOfCalls(t, "DeviceByName", expectedCalls)
+		})
+	}
+}
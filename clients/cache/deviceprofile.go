@@ -0,0 +1,193 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// DeviceProfileClient caches the profiles returned by DeviceProfileByName and the device resources returned by
+// DeviceResourceByProfileNameAndResourceName, the other methods being passed to the decorated client.
+// The profiles changed through this client are evicted from the cache along with their device resources.
+type DeviceProfileClient struct {
+	interfaces.DeviceProfileClient
+	profiles  *ttlCache
+	resources *ttlCache
+}
+
+// NewDeviceProfileClient creates a DeviceProfileClient caching the profiles and device resources for ttl and the
+// NotFound errors for notFoundTTL. A notFoundTTL <= 0 disables the caching of NotFound errors.
+func NewDeviceProfileClient(client interfaces.DeviceProfileClient, ttl time.Duration, notFoundTTL time.Duration) *DeviceProfileClient {
+	return &DeviceProfileClient{
+		DeviceProfileClient: client,
+		profiles:            newTTLCache(ttl, notFoundTTL),
+		resources:           newTTLCache(ttl, notFoundTTL),
+	}
+}
+
+// resourceKey is the cache key of a device resource, the names being made of RFC 3986 unreserved characters only
+func resourceKey(profileName string, resourceName string) string {
+	return profileName + "/" + resourceName
+}
+
+// invalidate evicts the profiles and their device resources
+func (dpc *DeviceProfileClient) invalidate(names ...string) {
+	dpc.profiles.invalidate(names...)
+	for _, name := range names {
+		dpc.resources.invalidatePrefix(resourceKey(name, ""))
+	}
+}
+
+// clear evicts all the profiles and device resources
+func (dpc *DeviceProfileClient) clear() {
+	dpc.profiles.clear()
+	dpc.resources.clear()
+}
+
+func (dpc *DeviceProfileClient) DeviceProfileByName(ctx context.Context, name string) (responses.DeviceProfileResponse, errors.EdgeX) {
+	res, err := dpc.profiles.get(name, func() (interface{}, errors.EdgeX) {
+		return dpc.DeviceProfileClient.DeviceProfileByName(ctx, name)
+	})
+	if err != nil {
+		return responses.DeviceProfileResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res.(responses.DeviceProfileResponse), nil
+}
+
+func (dpc *DeviceProfileClient) DeviceResourceByProfileNameAndResourceName(ctx context.Context, profileName string, resourceName string) (
+	responses.DeviceResourceResponse, errors.EdgeX) {
+	res, err := dpc.resources.get(resourceKey(profileName, resourceName), func() (interface{}, errors.EdgeX) {
+		return dpc.DeviceProfileClient.DeviceResourceByProfileNameAndResourceName(ctx, profileName, resourceName)
+	})
+	if err != nil {
+		return responses.DeviceResourceResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res.(responses.DeviceResourceResponse), nil
+}
+
+func (dpc *DeviceProfileClient) Add(ctx context.Context, reqs []requests.DeviceProfileRequest) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	res, err := dpc.DeviceProfileClient.Add(ctx, reqs)
+	// Evict the NotFound errors of the added profiles
+	for _, req := range reqs {
+		dpc.invalidate(req.Profile.Name)
+	}
+	return res, err
+}
+
+func (dpc *DeviceProfileClient) Update(ctx context.Context, reqs []requests.DeviceProfileRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dpc.DeviceProfileClient.Update(ctx, reqs)
+	for _, req := range reqs {
+		dpc.invalidate(req.Profile.Name)
+	}
+	return res, err
+}
+
+func (dpc *DeviceProfileClient) AddByYaml(ctx context.Context, yamlFilePath string) (dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	res, err := dpc.DeviceProfileClient.AddByYaml(ctx, yamlFilePath)
+	// The profile name is only known by parsing the file
+	dpc.clear()
+	return res, err
+}
+
+func (dpc *DeviceProfileClient) UpdateByYaml(ctx context.Context, yamlFilePath string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dpc.DeviceProfileClient.UpdateByYaml(ctx, yamlFilePath)
+	dpc.clear()
+	return res, err
+}
+
+func (dpc *DeviceProfileClient) DeleteByName(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dpc.DeviceProfileClient.DeleteByName(ctx, name)
+	dpc.invalidate(name)
+	return res, err
+}
+
+// DeleteById clears the caches, which are keyed by profile name
+func (dpc *DeviceProfileClient) DeleteById(ctx context.Context, id string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dpc.DeviceProfileClient.DeleteById(ctx, id)
+	dpc.clear()
+	return res, err
+}
+
+func (dpc *DeviceProfileClient) UpdateDeviceProfileBasicInfo(ctx context.Context, reqs []requests.DeviceProfileBasicInfoRequest) (
+	[]dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dpc.DeviceProfileClient.UpdateDeviceProfileBasicInfo(ctx, reqs)
+	names := make([]*string, len(reqs))
+	for i, req := range reqs {
+		names[i] = req.BasicInfo.Name
+	}
+	if updated, ok := updatedNames(names); ok {
+		dpc.invalidate(updated...)
+	} else {
+		dpc.clear()
+	}
+	return res, err
+}
+
+func (dpc *DeviceProfileClient) AddDeviceProfileResource(ctx context.Context, reqs []requests.AddDeviceResourceRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dpc.DeviceProfileClient.AddDeviceProfileResource(ctx, reqs)
+	for _, req := range reqs {
+		dpc.invalidate(req.ProfileName)
+	}
+	return res, err
+}
+
+func (dpc *DeviceProfileClient) UpdateDeviceProfileResource(ctx context.Context, reqs []requests.UpdateDeviceResourceRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dpc.DeviceProfileClient.UpdateDeviceProfileResource(ctx, reqs)
+	for _, req := range reqs {
+		dpc.invalidate(req.ProfileName)
+	}
+	return res, err
+}
+
+func (dpc *DeviceProfileClient) DeleteDeviceResourceByName(ctx context.Context, profileName string, resourceName string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dpc.DeviceProfileClient.DeleteDeviceResourceByName(ctx, profileName, resourceName)
+	dpc.invalidate(profileName)
+	return res, err
+}
+
+func (dpc *DeviceProfileClient) AddDeviceProfileDeviceCommand(ctx context.Context, reqs []requests.AddDeviceCommandRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dpc.DeviceProfileClient.AddDeviceProfileDeviceCommand(ctx, reqs)
+	for _, req := range reqs {
+		dpc.invalidate(req.ProfileName)
+	}
+	return res, err
+}
+
+func (dpc *DeviceProfileClient) UpdateDeviceProfileDeviceCommand(ctx context.Context, reqs []requests.UpdateDeviceCommandRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dpc.DeviceProfileClient.UpdateDeviceProfileDeviceCommand(ctx, reqs)
+	for _, req := range reqs {
+		dpc.invalidate(req.ProfileName)
+	}
+	return res, err
+}
+
+func (dpc *DeviceProfileClient) DeleteDeviceCommandByName(ctx context.Context, profileName string, commandName string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dpc.DeviceProfileClient.DeleteDeviceCommandByName(ctx, profileName, commandName)
+	dpc.invalidate(profileName)
+	return res, err
+}
+
+// Invalidate evicts the profile the device profile system event is about along with its device resources, or all the
+// profiles if the event carries no profile name
+func (dpc *DeviceProfileClient) Invalidate(event dtos.SystemEvent) {
+	if event.Type != common.DeviceProfileSystemEventType || !invalidatingAction(event.Action) {
+		return
+	}
+	if name := systemEventName(event); name != "" {
+		dpc.invalidate(name)
+		return
+	}
+	dpc.clear()
+}
@@ -0,0 +1,129 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+)
+
+const TestResourceName = "Int8"
+
+func newMockDeviceProfileClient() *mocks.DeviceProfileClient {
+	profile := dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: TestProfileName}}
+	mockClient := &mocks.DeviceProfileClient{}
+	mockClient.On("DeviceProfileByName", mock.Anything, TestProfileName).Return(responses.DeviceProfileResponse{Profile: profile}, nil)
+	mockClient.On("DeviceResourceByProfileNameAndResourceName", mock.Anything, TestProfileName, TestResourceName).
+		Return(responses.DeviceResourceResponse{Resource: dtos.DeviceResource{Name: TestResourceName}}, nil)
+	return mockClient
+}
+
+// lookUpProfile looks up the profile and its device resource twice, which should reach the decorated client at most once
+func lookUpProfile(t *testing.T, client *DeviceProfileClient) {
+	for i := 0; i < 2; i++ {
+		profile, err := client.DeviceProfileByName(context.Background(), TestProfileName)
+		require.NoError(t, err)
+		assert.Equal(t, TestProfileName, profile.Profile.Name)
+		resource, err := client.DeviceResourceByProfileNameAndResourceName(context.Background(), TestProfileName, TestResourceName)
+		require.NoError(t, err)
+		assert.Equal(t, TestResourceName, resource.Resource.Name)
+	}
+}
+
+func TestDeviceProfileClient_Mutations(t *testing.T) {
+	name := TestProfileName
+	tests := []struct {
+		method    string
+		arguments int
+		result    interface{}
+		mutate    func(client *DeviceProfileClient)
+	}{
+		{"Add", 2, []dtoCommon.BaseWithIdResponse{}, func(client *DeviceProfileClient) {
+			_, _ = client.Add(context.Background(), []requests.DeviceProfileRequest{{Profile: dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: TestProfileName}}}})
+		}},
+		{"Update", 2, []dtoCommon.BaseResponse{}, func(client *DeviceProfileClient) {
+			_, _ = client.Update(context.Background(), []requests.DeviceProfileRequest{{Profile: dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: TestProfileName}}}})
+		}},
+		{"AddByYaml", 2, dtoCommon.BaseWithIdResponse{}, func(client *DeviceProfileClient) {
+			_, _ = client.AddByYaml(context.Background(), "profile.yaml")
+		}},
+		{"UpdateByYaml", 2, dtoCommon.BaseResponse{}, func(client *DeviceProfileClient) {
+			_, _ = client.UpdateByYaml(context.Background(), "profile.yaml")
+		}},
+		{"DeleteByName", 2, dtoCommon.BaseResponse{}, func(client *DeviceProfileClient) {
+			_, _ = client.DeleteByName(context.Background(), TestProfileName)
+		}},
+		{"DeleteById", 2, dtoCommon.BaseResponse{}, func(client *DeviceProfileClient) {
+			_, _ = client.DeleteById(context.Background(), "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc")
+		}},
+		{"UpdateDeviceProfileBasicInfo", 2, []dtoCommon.BaseResponse{}, func(client *DeviceProfileClient) {
+			_, _ = client.UpdateDeviceProfileBasicInfo(context.Background(), []requests.DeviceProfileBasicInfoRequest{{BasicInfo: dtos.UpdateDeviceProfileBasicInfo{Name: &name}}})
+		}},
+		{"AddDeviceProfileResource", 2, []dtoCommon.BaseResponse{}, func(client *DeviceProfileClient) {
+			_, _ = client.AddDeviceProfileResource(context.Background(), []requests.AddDeviceResourceRequest{{ProfileName: TestProfileName}})
+		}},
+		{"UpdateDeviceProfileResource", 2, []dtoCommon.BaseResponse{}, func(client *DeviceProfileClient) {
+			_, _ = client.UpdateDeviceProfileResource(context.Background(), []requests.UpdateDeviceResourceRequest{{ProfileName: TestProfileName}})
+		}},
+		{"DeleteDeviceResourceByName", 3, dtoCommon.BaseResponse{}, func(client *DeviceProfileClient) {
+			_, _ = client.DeleteDeviceResourceByName(context.Background(), TestProfileName, TestResourceName)
+		}},
+		{"AddDeviceProfileDeviceCommand", 2, []dtoCommon.BaseResponse{}, func(client *DeviceProfileClient) {
+			_, _ = client.AddDeviceProfileDeviceCommand(context.Background(), []requests.AddDeviceCommandRequest{{ProfileName: TestProfileName}})
+		}},
+		{"UpdateDeviceProfileDeviceCommand", 2, []dtoCommon.BaseResponse{}, func(client *DeviceProfileClient) {
+			_, _ = client.UpdateDeviceProfileDeviceCommand(context.Background(), []requests.UpdateDeviceCommandRequest{{ProfileName: TestProfileName}})
+		}},
+		{"DeleteDeviceCommandByName", 3, dtoCommon.BaseResponse{}, func(client *DeviceProfileClient) {
+			_, _ = client.DeleteDeviceCommandByName(context.Background(), TestProfileName, "command")
+		}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.method, func(t *testing.T) {
+			mockClient := newMockDeviceProfileClient()
+			arguments := make([]interface{}, testCase.arguments)
+			for i := range arguments {
+				arguments[i] = mock.Anything
+			}
+			mockClient.On(testCase.method, arguments...).Return(testCase.result, nil)
+			client := NewDeviceProfileClient(mockClient, time.Minute, time.Minute)
+
+			lookUpProfile(t, client)
+			testCase.mutate(client)
+			lookUpProfile(t, client)
+			mockClient.AssertNumberOfCalls(t, "DeviceProfileByName", 2)
+			mockClient.AssertNumberOfCalls(t, "DeviceResourceByProfileNameAndResourceName", 2)
+		})
+	}
+}
+
+func TestDeviceProfileClient_Invalidate(t *testing.T) {
+	profile := dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: TestProfileName}}
+	mockClient := newMockDeviceProfileClient()
+	client := NewDeviceProfileClient(mockClient, time.Minute, time.Minute)
+
+	lookUpProfile(t, client)
+	client.Invalidate(dtos.NewSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, "", nil, profile))
+	lookUpProfile(t, client)
+	mockClient.AssertNumberOfCalls(t, "DeviceProfileByName", 1)
+
+	client.Invalidate(dtos.NewSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, "", nil, profile))
+	lookUpProfile(t, client)
+	mockClient.AssertNumberOfCalls(t, "DeviceProfileByName", 2)
+	mockClient.AssertNumberOfCalls(t, "DeviceResourceByProfileNameAndResourceName", 2)
+}
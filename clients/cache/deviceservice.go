@@ -0,0 +1,94 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// DeviceServiceClient caches the device services returned by DeviceServiceByName, the other methods being passed to the
+// decorated client. The device services changed through this client are evicted from the cache.
+type DeviceServiceClient struct {
+	interfaces.DeviceServiceClient
+	cache *ttlCache
+}
+
+// NewDeviceServiceClient creates a DeviceServiceClient caching the device services for ttl and the NotFound errors for
+// notFoundTTL. A notFoundTTL <= 0 disables the caching of NotFound errors.
+func NewDeviceServiceClient(client interfaces.DeviceServiceClient, ttl time.Duration, notFoundTTL time.Duration) *DeviceServiceClient {
+	return &DeviceServiceClient{
+		DeviceServiceClient: client,
+		cache:               newTTLCache(ttl, notFoundTTL),
+	}
+}
+
+func (dsc *DeviceServiceClient) DeviceServiceByName(ctx context.Context, name string) (responses.DeviceServiceResponse, errors.EdgeX) {
+	res, err := dsc.cache.get(name, func() (interface{}, errors.EdgeX) {
+		return dsc.DeviceServiceClient.DeviceServiceByName(ctx, name)
+	})
+	if err != nil {
+		return responses.DeviceServiceResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res.(responses.DeviceServiceResponse), nil
+}
+
+func (dsc *DeviceServiceClient) Add(ctx context.Context, reqs []requests.AddDeviceServiceRequest) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	res, err := dsc.DeviceServiceClient.Add(ctx, reqs)
+	// Evict the NotFound errors of the added device services
+	for _, req := range reqs {
+		dsc.cache.invalidate(req.Service.Name)
+	}
+	return res, err
+}
+
+func (dsc *DeviceServiceClient) Update(ctx context.Context, reqs []requests.UpdateDeviceServiceRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dsc.DeviceServiceClient.Update(ctx, reqs)
+	names := make([]*string, len(reqs))
+	for i, req := range reqs {
+		names[i] = req.Service.Name
+	}
+	if updated, ok := updatedNames(names); ok {
+		dsc.cache.invalidate(updated...)
+	} else {
+		dsc.cache.clear()
+	}
+	return res, err
+}
+
+func (dsc *DeviceServiceClient) DeleteByName(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dsc.DeviceServiceClient.DeleteByName(ctx, name)
+	dsc.cache.invalidate(name)
+	return res, err
+}
+
+// DeleteById clears the cache, which is keyed by device service name
+func (dsc *DeviceServiceClient) DeleteById(ctx context.Context, id string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dsc.DeviceServiceClient.DeleteById(ctx, id)
+	dsc.cache.clear()
+	return res, err
+}
+
+// Invalidate evicts the device service the device service system event is about, or all the device services if the
+// event carries no device service name
+func (dsc *DeviceServiceClient) Invalidate(event dtos.SystemEvent) {
+	if event.Type != common.DeviceServiceSystemEventType || !invalidatingAction(event.Action) {
+		return
+	}
+	if name := systemEventName(event); name != "" {
+		dsc.cache.invalidate(name)
+		return
+	}
+	dsc.cache.clear()
+}
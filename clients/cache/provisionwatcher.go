@@ -0,0 +1,94 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// ProvisionWatcherClient caches the provision watchers returned by ProvisionWatcherByName, the other methods being
+// passed to the decorated client. The provision watchers changed through this client are evicted from the cache.
+type ProvisionWatcherClient struct {
+	interfaces.ProvisionWatcherClient
+	cache *ttlCache
+}
+
+// NewProvisionWatcherClient creates a ProvisionWatcherClient caching the provision watchers for ttl and the NotFound
+// errors for notFoundTTL. A notFoundTTL <= 0 disables the caching of NotFound errors.
+func NewProvisionWatcherClient(client interfaces.ProvisionWatcherClient, ttl time.Duration, notFoundTTL time.Duration) *ProvisionWatcherClient {
+	return &ProvisionWatcherClient{
+		ProvisionWatcherClient: client,
+		cache:                  newTTLCache(ttl, notFoundTTL),
+	}
+}
+
+func (pwc *ProvisionWatcherClient) ProvisionWatcherByName(ctx context.Context, name string) (responses.ProvisionWatcherResponse, errors.EdgeX) {
+	res, err := pwc.cache.get(name, func() (interface{}, errors.EdgeX) {
+		return pwc.ProvisionWatcherClient.ProvisionWatcherByName(ctx, name)
+	})
+	if err != nil {
+		return responses.ProvisionWatcherResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res.(responses.ProvisionWatcherResponse), nil
+}
+
+func (pwc *ProvisionWatcherClient) Add(ctx context.Context, reqs []requests.AddProvisionWatcherRequest) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	res, err := pwc.ProvisionWatcherClient.Add(ctx, reqs)
+	// Evict the NotFound errors of the added provision watchers
+	for _, req := range reqs {
+		pwc.cache.invalidate(req.ProvisionWatcher.Name)
+	}
+	return res, err
+}
+
+func (pwc *ProvisionWatcherClient) Update(ctx context.Context, reqs []requests.UpdateProvisionWatcherRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := pwc.ProvisionWatcherClient.Update(ctx, reqs)
+	names := make([]*string, len(reqs))
+	for i, req := range reqs {
+		names[i] = req.ProvisionWatcher.Name
+	}
+	if updated, ok := updatedNames(names); ok {
+		pwc.cache.invalidate(updated...)
+	} else {
+		pwc.cache.clear()
+	}
+	return res, err
+}
+
+func (pwc *ProvisionWatcherClient) DeleteProvisionWatcherByName(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := pwc.ProvisionWatcherClient.DeleteProvisionWatcherByName(ctx, name)
+	pwc.cache.invalidate(name)
+	return res, err
+}
+
+// DeleteProvisionWatcherById clears the cache, which is keyed by provision watcher name
+func (pwc *ProvisionWatcherClient) DeleteProvisionWatcherById(ctx context.Context, id string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := pwc.ProvisionWatcherClient.DeleteProvisionWatcherById(ctx, id)
+	pwc.cache.clear()
+	return res, err
+}
+
+// Invalidate evicts the provision watcher the provision watcher system event is about, or all the provision watchers if
+// the event carries no provision watcher name
+func (pwc *ProvisionWatcherClient) Invalidate(event dtos.SystemEvent) {
+	if event.Type != common.ProvisionWatcherSystemEventType || !invalidatingAction(event.Action) {
+		return
+	}
+	if name := systemEventName(event); name != "" {
+		pwc.cache.invalidate(name)
+		return
+	}
+	pwc.cache.clear()
+}
@@ -0,0 +1,200 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// CommandStatus is the status of an asynchronous command request
+type CommandStatus string
+
+const (
+	// StatusPending is the status of a request waiting for the response of the device service
+	StatusPending CommandStatus = "Pending"
+	// StatusSucceeded is the status of a request which completed successfully
+	StatusSucceeded CommandStatus = "Succeeded"
+	// StatusFailed is the status of a request which failed or timed out
+	StatusFailed CommandStatus = "Failed"
+	// StatusCancelled is the status of a request cancelled through its handle
+	StatusCancelled CommandStatus = "Cancelled"
+)
+
+// CommandResult is the result of an asynchronous command request
+type CommandResult struct {
+	// Event is the response of a GET command, nil if the device service returned no event
+	Event *responses.EventResponse
+	// Response is the response of a SET command
+	Response dtoCommon.BaseResponse
+}
+
+// CommandHandle tracks an asynchronous command request, whose result can be polled or awaited
+type CommandHandle struct {
+	requestId     string
+	correlationId string
+	cancel        context.CancelFunc
+	done          chan struct{}
+	mutex         sync.Mutex
+	status        CommandStatus
+	cancelled     bool
+	result        CommandResult
+	err           errors.EdgeX
+}
+
+// RequestId returns the id identifying the request
+func (h *CommandHandle) RequestId() string {
+	return h.requestId
+}
+
+// CorrelationId returns the correlation id sent with the request, which is the request id unless the context of the
+// request already carried one
+func (h *CommandHandle) CorrelationId() string {
+	return h.correlationId
+}
+
+// Status returns the current status of the request
+func (h *CommandHandle) Status() CommandStatus {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.status
+}
+
+// Done returns a channel closed once the request completed
+func (h *CommandHandle) Done() <-chan struct{} {
+	return h.done
+}
+
+// Result returns the result of the request and true if it completed, or false if it is still pending
+func (h *CommandHandle) Result() (CommandResult, errors.EdgeX, bool) {
+	select {
+	case <-h.done:
+		return h.result, h.err, true
+	default:
+		return CommandResult{}, nil, false
+	}
+}
+
+// Wait waits until the request completes and returns its result. If the context is done first, Wait returns a
+// KindServiceUnavailable error while the request keeps running.
+func (h *CommandHandle) Wait(ctx context.Context) (CommandResult, errors.EdgeX) {
+	select {
+	case <-h.done:
+		return h.result, h.err
+	case <-ctx.Done():
+		return CommandResult{}, errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("gave up waiting for command request %s", h.requestId), ctx.Err())
+	}
+}
+
+// Cancel cancels the request, aborting the underlying HTTP request if it is still in progress
+func (h *CommandHandle) Cancel() {
+	h.mutex.Lock()
+	if h.status == StatusPending {
+		h.cancelled = true
+	}
+	h.mutex.Unlock()
+	h.cancel()
+}
+
+// complete records the outcome of the request and releases its waiters
+func (h *CommandHandle) complete(result CommandResult, err errors.EdgeX) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	switch {
+	case err == nil:
+		h.status = StatusSucceeded
+	case h.cancelled:
+		h.status = StatusCancelled
+		err = errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("command request %s was cancelled", h.requestId), err)
+	default:
+		h.status = StatusFailed
+	}
+	h.result = result
+	h.err = err
+	h.cancel()
+	close(h.done)
+}
+
+// AsyncCommandClient issues the commands of the device services without blocking the caller, each request being
+// tracked by a CommandHandle
+type AsyncCommandClient struct {
+	client  interfaces.DeviceServiceCommandClient
+	timeout time.Duration
+}
+
+// NewAsyncCommandClient creates an AsyncCommandClient issuing the commands with the client. A timeout > 0 bounds the
+// duration of each request, the requests being otherwise only bounded by their context.
+func NewAsyncCommandClient(client interfaces.DeviceServiceCommandClient, timeout time.Duration) *AsyncCommandClient {
+	return &AsyncCommandClient{
+		client:  client,
+		timeout: timeout,
+	}
+}
+
+// GetCommand starts the GET command and returns its handle. The request is cancelled when ctx is done, so ctx must
+// outlive the request, e.g. context.Background() for a request outliving the caller.
+func (c *AsyncCommandClient) GetCommand(ctx context.Context, baseUrl string, deviceName string, commandName string, queryParams string) *CommandHandle {
+	return c.start(ctx, func(ctx context.Context) (CommandResult, errors.EdgeX) {
+		res, err := c.client.GetCommand(ctx, baseUrl, deviceName, commandName, queryParams)
+		return CommandResult{Event: res}, err
+	})
+}
+
+// SetCommand starts the SET command and returns its handle. The request is cancelled when ctx is done.
+func (c *AsyncCommandClient) SetCommand(ctx context.Context, baseUrl string, deviceName string, commandName string, queryParams string, settings map[string]string) *CommandHandle {
+	return c.start(ctx, func(ctx context.Context) (CommandResult, errors.EdgeX) {
+		res, err := c.client.SetCommand(ctx, baseUrl, deviceName, commandName, queryParams, settings)
+		return CommandResult{Response: res}, err
+	})
+}
+
+// SetCommandWithObject starts the SET command whose settings support the object value type and returns its handle.
+// The request is cancelled when ctx is done.
+func (c *AsyncCommandClient) SetCommandWithObject(ctx context.Context, baseUrl string, deviceName string, commandName string, queryParams string, settings map[string]interface{}) *CommandHandle {
+	return c.start(ctx, func(ctx context.Context) (CommandResult, errors.EdgeX) {
+		res, err := c.client.SetCommandWithObject(ctx, baseUrl, deviceName, commandName, queryParams, settings)
+		return CommandResult{Response: res}, err
+	})
+}
+
+// start runs the command in the background with a new request id, which is also sent as the correlation id unless ctx
+// already carries one
+func (c *AsyncCommandClient) start(ctx context.Context, command func(ctx context.Context) (CommandResult, errors.EdgeX)) *CommandHandle {
+	requestId := dtoCommon.NewBaseRequest().RequestId
+	correlationId := utils.FromContext(ctx, common.CorrelationHeader)
+	if correlationId == "" {
+		correlationId = requestId
+		ctx = context.WithValue(ctx, common.CorrelationHeader, correlationId) // nolint:staticcheck
+	}
+
+	var cancel context.CancelFunc
+	if c.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	handle := &CommandHandle{
+		requestId:     requestId,
+		correlationId: correlationId,
+		cancel:        cancel,
+		done:          make(chan struct{}),
+		status:        StatusPending,
+	}
+	go func() {
+		result, err := command(ctx)
+		handle.complete(result, err)
+	}()
+	return handle
+}
@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientHttp "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+const (
+	TestBaseUrl    = "http://localhost:59900"
+	TestDeviceName = "TestDevice"
+)
+
+func TestAsyncCommandClient_GetCommand(t *testing.T) {
+	release := make(chan struct{})
+	var correlationId string
+	event := &responses.EventResponse{Event: dtos.Event{DeviceName: TestDeviceName}}
+	mockClient := &mocks.DeviceServiceCommandClient{}
+	mockClient.On("GetCommand", mock.Anything, TestBaseUrl, TestDeviceName, TestCommandName, "").Return(
+		func(ctx context.Context, baseUrl string, deviceName string, commandName string, queryParams string) *responses.EventResponse {
+			correlationId = ctx.Value(common.CorrelationHeader).(string)
+			<-release
+			return event
+		}, nil)
+
+	handle := NewAsyncCommandClient(mockClient, 0).GetCommand(context.Background(), TestBaseUrl, TestDeviceName, TestCommandName, "")
+	assert.NotEmpty(t, handle.RequestId())
+	assert.Equal(t, handle.RequestId(), handle.CorrelationId())
+	assert.Equal(t, StatusPending, handle.Status())
+	_, _, completed := handle.Result()
+	assert.False(t, completed)
+
+	// Waiting is bounded by the context of the waiter
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := handle.Wait(ctx)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	assert.Equal(t, StatusPending, handle.Status())
+
+	close(release)
+	result, err := handle.Wait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, event, result.Event)
+	assert.Equal(t, StatusSucceeded, handle.Status())
+	assert.Equal(t, handle.CorrelationId(), correlationId)
+	result, err, completed = handle.Result()
+	assert.True(t, completed)
+	assert.NoError(t, err)
+	assert.Equal(t, event, result.Event)
+}
+
+func TestAsyncCommandClient_SetCommand(t *testing.T) {
+	settings := map[string]string{"resource": "value"}
+	failure := errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid value", nil)
+	mockClient := &mocks.DeviceServiceCommandClient{}
+	mockClient.On("SetCommand", mock.Anything, TestBaseUrl, TestDeviceName, TestCommandName, "", settings).Return(dtoCommon.BaseResponse{}, failure)
+	mockClient.On("SetCommandWithObject", mock.Anything, TestBaseUrl, TestDeviceName, TestCommandName, "", mock.Anything).Return(dtoCommon.NewBaseResponse("", "", http.StatusOK), nil)
+
+	// The correlation id of the caller is kept
+	ctx := context.WithValue(context.Background(), common.CorrelationHeader, "correlation") // nolint:staticcheck
+	client := NewAsyncCommandClient(mockClient, time.Second)
+	handle := client.SetCommand(ctx, TestBaseUrl, TestDeviceName, TestCommandName, "", settings)
+	assert.Equal(t, "correlation", handle.CorrelationId())
+	assert.NotEqual(t, handle.RequestId(), handle.CorrelationId())
+	<-handle.Done()
+	_, err, _ := handle.Result()
+	assert.Equal(t, failure, err)
+	assert.Equal(t, StatusFailed, handle.Status())
+
+	handle = client.SetCommandWithObject(ctx, TestBaseUrl, TestDeviceName, TestCommandName, "", map[string]interface{}{"resource": 1})
+	result, err := handle.Wait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, result.Response.StatusCode)
+	assert.Equal(t, StatusSucceeded, handle.Status())
+}
+
+func TestAsyncCommandClient_Cancel(t *testing.T) {
+	received := make(chan struct{})
+	aborted := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(received)
+		<-r.Context().Done()
+		close(aborted)
+	}))
+	defer ts.Close()
+
+	handle := NewAsyncCommandClient(clientHttp.NewDeviceServiceCommandClient(), 0).GetCommand(context.Background(), ts.URL, TestDeviceName, TestCommandName, "")
+	<-received
+	handle.Cancel()
+	_, err := handle.Wait(context.Background())
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	assert.Equal(t, StatusCancelled, handle.Status())
+	select {
+	case <-aborted:
+	case <-time.After(time.Second):
+		assert.Fail(t, "the cancellation should abort the HTTP request")
+	}
+
+	// Cancelling a completed request has no effect
+	handle.Cancel()
+	assert.Equal(t, StatusCancelled, handle.Status())
+}
+
+func TestAsyncCommandClient_Timeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+			_ = json.NewEncoder(w).Encode(responses.EventResponse{})
+		}
+	}))
+	defer ts.Close()
+
+	handle := NewAsyncCommandClient(clientHttp.NewDeviceServiceCommandClient(), 20*time.Millisecond).GetCommand(context.Background(), ts.URL, TestDeviceName, TestCommandName, "")
+	_, err := handle.Wait(context.Background())
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	assert.Equal(t, StatusFailed, handle.Status())
+}
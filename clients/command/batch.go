@@ -0,0 +1,208 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package command provides helpers built on the command clients, such as issuing a command to many devices at once or
+// without blocking the caller.
+package command
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// devicePageSize is the number of devices queried per request when selecting the devices from core-metadata
+const devicePageSize = 100
+
+// DeviceSelector returns the names of the devices a batch command is issued to
+type DeviceSelector func(ctx context.Context) ([]string, errors.EdgeX)
+
+// DeviceNames selects the given devices
+func DeviceNames(names ...string) DeviceSelector {
+	return func(ctx context.Context) ([]string, errors.EdgeX) {
+		return names, nil
+	}
+}
+
+// DevicesByProfileName selects all the devices associated with the device profile
+func DevicesByProfileName(client interfaces.DeviceClient, profileName string) DeviceSelector {
+	return func(ctx context.Context) ([]string, errors.EdgeX) {
+		names, err := collectDeviceNames(func(offset int) (responses.MultiDevicesResponse, errors.EdgeX) {
+			return client.DevicesByProfileName(ctx, profileName, offset, devicePageSize)
+		})
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query the devices of profile %s", profileName), err)
+		}
+		return names, nil
+	}
+}
+
+// DevicesByLabels selects all the devices having all the labels
+func DevicesByLabels(client interfaces.DeviceClient, labels ...string) DeviceSelector {
+	return func(ctx context.Context) ([]string, errors.EdgeX) {
+		names, err := collectDeviceNames(func(offset int) (responses.MultiDevicesResponse, errors.EdgeX) {
+			return client.AllDevices(ctx, labels, offset, devicePageSize)
+		})
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query the devices with labels %v", labels), err)
+		}
+		return names, nil
+	}
+}
+
+// collectDeviceNames pages through the devices returned by query until all of them are collected
+func collectDeviceNames(query func(offset int) (responses.MultiDevicesResponse, errors.EdgeX)) ([]string, errors.EdgeX) {
+	var names []string
+	for {
+		res, err := query(len(names))
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		for _, device := range res.Devices {
+			names = append(names, device.Name)
+		}
+		if len(res.Devices) == 0 || uint32(len(names)) >= res.TotalCount {
+			return names, nil
+		}
+	}
+}
+
+// DeviceResult is the outcome of the command issued to one device
+type DeviceResult struct {
+	DeviceName string
+	// Event is the response of a GET command, nil for a SET command or if the command failed
+	Event *responses.EventResponse
+	// Response is the response of a SET command
+	Response dtoCommon.BaseResponse
+	// Error is the error the command failed with, nil if it succeeded
+	Error errors.EdgeX
+}
+
+// BatchResult aggregates the outcomes of a batch command, in the order of the selected devices
+type BatchResult struct {
+	Results []DeviceResult
+}
+
+// Succeeded returns the results of the devices whose command succeeded
+func (r BatchResult) Succeeded() []DeviceResult {
+	var results []DeviceResult
+	for _, result := range r.Results {
+		if result.Error == nil {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// Failed returns the results of the devices whose command failed
+func (r BatchResult) Failed() []DeviceResult {
+	var results []DeviceResult
+	for _, result := range r.Results {
+		if result.Error != nil {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// Errors returns the errors of the failed commands by device name
+func (r BatchResult) Errors() map[string]errors.EdgeX {
+	errs := make(map[string]errors.EdgeX)
+	for _, result := range r.Results {
+		if result.Error != nil {
+			errs[result.DeviceName] = result.Error
+		}
+	}
+	return errs
+}
+
+// BatchExecutor issues a command to many devices through core-command, sending at most maxConcurrency requests at a
+// time and giving each device up to timeout to respond
+type BatchExecutor struct {
+	client         interfaces.CommandClient
+	maxConcurrency int
+	timeout        time.Duration
+}
+
+// NewBatchExecutor creates a BatchExecutor issuing the commands with the client. A maxConcurrency < 1 sends one request
+// at a time and a timeout <= 0 only bounds the commands by the context of the batch.
+func NewBatchExecutor(client interfaces.CommandClient, maxConcurrency int, timeout time.Duration) *BatchExecutor {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+	return &BatchExecutor{
+		client:         client,
+		maxConcurrency: maxConcurrency,
+		timeout:        timeout,
+	}
+}
+
+// IssueGetCommand issues the GET command to the selected devices. The returned error is only about the selection of
+// the devices, the errors of the commands being reported by the result of each device.
+func (e *BatchExecutor) IssueGetCommand(ctx context.Context, devices DeviceSelector, commandName string, dsPushEvent bool, dsReturnEvent bool) (BatchResult, errors.EdgeX) {
+	return e.execute(ctx, devices, func(ctx context.Context, deviceName string) DeviceResult {
+		res, err := e.client.IssueGetCommandByName(ctx, deviceName, commandName, dsPushEvent, dsReturnEvent)
+		return DeviceResult{DeviceName: deviceName, Event: res, Error: err}
+	})
+}
+
+// IssueSetCommand issues the SET command with the settings to the selected devices. The returned error is only about
+// the selection of the devices, the errors of the commands being reported by the result of each device.
+func (e *BatchExecutor) IssueSetCommand(ctx context.Context, devices DeviceSelector, commandName string, settings map[string]interface{}) (BatchResult, errors.EdgeX) {
+	return e.execute(ctx, devices, func(ctx context.Context, deviceName string) DeviceResult {
+		res, err := e.client.IssueSetCommandByNameWithObject(ctx, deviceName, commandName, settings)
+		return DeviceResult{DeviceName: deviceName, Response: res, Error: err}
+	})
+}
+
+// execute runs the command on each selected device with bounded concurrency. The devices not reached yet when the
+// context is done are reported as failed without issuing their command.
+func (e *BatchExecutor) execute(ctx context.Context, devices DeviceSelector, command func(ctx context.Context, deviceName string) DeviceResult) (BatchResult, errors.EdgeX) {
+	deviceNames, err := devices(ctx)
+	if err != nil {
+		return BatchResult{}, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	results := make([]DeviceResult, len(deviceNames))
+	slots := make(chan struct{}, e.maxConcurrency)
+	var wg sync.WaitGroup
+	for i, deviceName := range deviceNames {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			results[i] = DeviceResult{
+				DeviceName: deviceName,
+				Error:      errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("the command was not issued to device %s", deviceName), ctx.Err()),
+			}
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, deviceName string) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			deviceCtx := ctx
+			if e.timeout > 0 {
+				var cancel context.CancelFunc
+				deviceCtx, cancel = context.WithTimeout(ctx, e.timeout)
+				defer cancel()
+			}
+			results[i] = command(deviceCtx, deviceName)
+			results[i].DeviceName = deviceName
+		}(i, deviceName)
+	}
+	wg.Wait()
+	return BatchResult{Results: results}, nil
+}
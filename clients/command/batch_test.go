@@ -0,0 +1,165 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+const (
+	TestCommandName = "TestCommand"
+	TestProfileName = "TestProfile"
+)
+
+func multiDevicesResponse(totalCount uint32, names ...string) responses.MultiDevicesResponse {
+	devices := make([]dtos.Device, len(names))
+	for i, name := range names {
+		devices[i] = dtos.Device{Name: name}
+	}
+	return responses.NewMultiDevicesResponse("", "", 200, totalCount, devices)
+}
+
+func TestDevicesByProfileName(t *testing.T) {
+	mockClient := &mocks.DeviceClient{}
+	mockClient.On("DevicesByProfileName", mock.Anything, TestProfileName, 0, devicePageSize).Return(multiDevicesResponse(3, "device1", "device2"), nil).Once()
+	mockClient.On("DevicesByProfileName", mock.Anything, TestProfileName, 2, devicePageSize).Return(multiDevicesResponse(3, "device3"), nil).Once()
+
+	names, err := DevicesByProfileName(mockClient, TestProfileName)(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"device1", "device2", "device3"}, names)
+	mockClient.AssertExpectations(t)
+
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "profile not found", nil)
+	mockClient.On("DevicesByProfileName", mock.Anything, "unknown", 0, devicePageSize).Return(responses.MultiDevicesResponse{}, notFound)
+	_, err = DevicesByProfileName(mockClient, "unknown")(context.Background())
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
+
+func TestDevicesByLabels(t *testing.T) {
+	labels := []string{"floor1", "hvac"}
+	mockClient := &mocks.DeviceClient{}
+	mockClient.On("AllDevices", mock.Anything, labels, 0, devicePageSize).Return(multiDevicesResponse(2, "device1", "device2"), nil).Once()
+
+	names, err := DevicesByLabels(mockClient, labels...)(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"device1", "device2"}, names)
+	mockClient.AssertExpectations(t)
+}
+
+func TestBatchExecutor_IssueGetCommand(t *testing.T) {
+	failure := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil)
+	mockClient := &mocks.CommandClient{}
+	for _, name := range []string{"device1", "device3"} {
+		event := &responses.EventResponse{Event: dtos.Event{DeviceName: name}}
+		mockClient.On("IssueGetCommandByName", mock.Anything, name, TestCommandName, false, true).Return(event, nil)
+	}
+	mockClient.On("IssueGetCommandByName", mock.Anything, "device2", TestCommandName, false, true).Return(nil, failure)
+
+	executor := NewBatchExecutor(mockClient, 2, time.Second)
+	result, err := executor.IssueGetCommand(context.Background(), DeviceNames("device1", "device2", "device3"), TestCommandName, false, true)
+	require.NoError(t, err)
+
+	require.Len(t, result.Results, 3)
+	for i, name := range []string{"device1", "device2", "device3"} {
+		assert.Equal(t, name, result.Results[i].DeviceName)
+	}
+	assert.Equal(t, "device1", result.Results[0].Event.Event.DeviceName)
+	assert.Equal(t, "device3", result.Results[2].Event.Event.DeviceName)
+	assert.Len(t, result.Succeeded(), 2)
+	require.Len(t, result.Failed(), 1)
+	assert.Equal(t, map[string]errors.EdgeX{"device2": failure}, result.Errors())
+}
+
+func TestBatchExecutor_IssueSetCommand(t *testing.T) {
+	settings := map[string]interface{}{"resource": "value"}
+	mockClient := &mocks.CommandClient{}
+	mockClient.On("IssueSetCommandByNameWithObject", mock.Anything, mock.Anything, TestCommandName, settings).Return(dtoCommon.NewBaseResponse("", "", 200), nil)
+	mockDeviceClient := &mocks.DeviceClient{}
+	mockDeviceClient.On("AllDevices", mock.Anything, []string{"hvac"}, 0, devicePageSize).Return(multiDevicesResponse(2, "device1", "device2"), nil)
+
+	result, err := NewBatchExecutor(mockClient, 4, 0).IssueSetCommand(context.Background(), DevicesByLabels(mockDeviceClient, "hvac"), TestCommandName, settings)
+	require.NoError(t, err)
+	require.Len(t, result.Succeeded(), 2)
+	assert.Equal(t, 200, result.Results[1].Response.StatusCode)
+	mockClient.AssertNumberOfCalls(t, "IssueSetCommandByNameWithObject", 2)
+
+	// The selection error fails the whole batch
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "profile not found", nil)
+	mockDeviceClient.On("DevicesByProfileName", mock.Anything, TestProfileName, 0, devicePageSize).Return(responses.MultiDevicesResponse{}, notFound)
+	_, err = NewBatchExecutor(mockClient, 4, 0).IssueSetCommand(context.Background(), DevicesByProfileName(mockDeviceClient, TestProfileName), TestCommandName, settings)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
+
+func TestBatchExecutor_Concurrency(t *testing.T) {
+	var running, maxRunning int32
+	mockClient := &mocks.CommandClient{}
+	mockClient.On("IssueGetCommandByName", mock.Anything, mock.Anything, TestCommandName, true, true).Return(
+		func(ctx context.Context, deviceName string, commandName string, dsPushEvent bool, dsReturnEvent bool) *responses.EventResponse {
+			current := atomic.AddInt32(&running, 1)
+			for {
+				observed := atomic.LoadInt32(&maxRunning)
+				if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return &responses.EventResponse{}
+		}, nil)
+
+	names := make([]string, 10)
+	for i := range names {
+		names[i] = fmt.Sprintf("device%d", i)
+	}
+	result, err := NewBatchExecutor(mockClient, 3, 0).IssueGetCommand(context.Background(), DeviceNames(names...), TestCommandName, true, true)
+	require.NoError(t, err)
+	assert.Len(t, result.Succeeded(), 10)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&maxRunning))
+}
+
+func TestBatchExecutor_Timeout(t *testing.T) {
+	mockClient := &mocks.CommandClient{}
+	mockClient.On("IssueGetCommandByName", mock.Anything, "slow", TestCommandName, true, true).Return(nil,
+		func(ctx context.Context, deviceName string, commandName string, dsPushEvent bool, dsReturnEvent bool) errors.EdgeX {
+			<-ctx.Done()
+			return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "failed to send a http request", ctx.Err())
+		})
+	mockClient.On("IssueGetCommandByName", mock.Anything, "fast", TestCommandName, true, true).Return(&responses.EventResponse{}, nil)
+
+	start := time.Now()
+	result, err := NewBatchExecutor(mockClient, 2, 20*time.Millisecond).IssueGetCommand(context.Background(), DeviceNames("slow", "fast"), TestCommandName, true, true)
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+	require.Len(t, result.Failed(), 1)
+	assert.Equal(t, "slow", result.Failed()[0].DeviceName)
+	assert.Equal(t, "fast", result.Succeeded()[0].DeviceName)
+}
+
+func TestBatchExecutor_Cancelled(t *testing.T) {
+	mockClient := &mocks.CommandClient{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := NewBatchExecutor(mockClient, 1, 0).IssueGetCommand(ctx, DeviceNames("device1", "device2"), TestCommandName, true, true)
+	require.NoError(t, err)
+	require.Len(t, result.Failed(), 2)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(result.Results[0].Error))
+	mockClient.AssertNotCalled(t, "IssueGetCommandByName", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,205 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// SetCommandBuilder builds the settings of a SET command from Go values, checking them against the parameters of the
+// command. The errors are collected while setting the values and returned when the settings are built.
+type SetCommandBuilder struct {
+	command  dtos.CoreCommand
+	settings map[string]interface{}
+	errs     []string
+}
+
+// NewSetCommandBuilder creates a SetCommandBuilder for the command, as described by core-command
+func NewSetCommandBuilder(command dtos.CoreCommand) *SetCommandBuilder {
+	return &SetCommandBuilder{
+		command:  command,
+		settings: make(map[string]interface{}),
+	}
+}
+
+// Set sets the value of the resource parameter. The value is either a Go value matching the value type of the
+// parameter, e.g. an int, a uint8 or a float64 for an Int16 parameter or a []bool for a BoolArray parameter, or its
+// string encoding, e.g. "[true, false]". The elements of a StringArray value cannot hold commas nor square brackets,
+// which the encoding does not escape. The value of an Object parameter is any value which can be encoded to JSON.
+func (b *SetCommandBuilder) Set(resourceName string, value interface{}) *SetCommandBuilder {
+	valueType, ok := b.valueType(resourceName)
+	if !ok {
+		b.errs = append(b.errs, fmt.Sprintf("resource %s is not a parameter of command %s", resourceName, b.command.Name))
+		return b
+	}
+	encoded, err := encodeSetValue(valueType, value)
+	if err != nil {
+		b.errs = append(b.errs, fmt.Sprintf("invalid %s value of resource %s: %v", valueType, resourceName, err))
+		return b
+	}
+	b.settings[resourceName] = encoded
+	return b
+}
+
+// Object returns the settings for CommandClient.IssueSetCommandByNameWithObject, the values of the Object parameters
+// being kept as is and the other values being encoded to strings
+func (b *SetCommandBuilder) Object() (map[string]interface{}, errors.EdgeX) {
+	if err := b.validate(); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	settings := make(map[string]interface{}, len(b.settings))
+	for resourceName, value := range b.settings {
+		settings[resourceName] = value
+	}
+	return settings, nil
+}
+
+// Settings returns the settings for CommandClient.IssueSetCommandByName, the values of the Object parameters being
+// encoded to JSON
+func (b *SetCommandBuilder) Settings() (map[string]string, errors.EdgeX) {
+	if err := b.validate(); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	settings := make(map[string]string, len(b.settings))
+	for resourceName, value := range b.settings {
+		if s, ok := value.(string); ok {
+			settings[resourceName] = s
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode the value of resource %s to JSON", resourceName), err)
+		}
+		settings[resourceName] = string(encoded)
+	}
+	return settings, nil
+}
+
+// validate checks that the command accepts SET requests, that every parameter was set and that no error occurred
+func (b *SetCommandBuilder) validate() errors.EdgeX {
+	errs := b.errs
+	if !b.command.Set {
+		errs = append([]string{fmt.Sprintf("command %s does not support SET", b.command.Name)}, errs...)
+	}
+	for _, parameter := range b.command.Parameters {
+		if _, ok := b.settings[parameter.ResourceName]; !ok {
+			errs = append(errs, fmt.Sprintf("parameter %s of command %s is not set", parameter.ResourceName, b.command.Name))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, strings.Join(errs, "; "), nil)
+	}
+	return nil
+}
+
+func (b *SetCommandBuilder) valueType(resourceName string) (string, bool) {
+	for _, parameter := range b.command.Parameters {
+		if parameter.ResourceName == resourceName {
+			return parameter.ValueType, true
+		}
+	}
+	return "", false
+}
+
+// encodeSetValue encodes the value to the string expected by the device services for the value type, or returns it as
+// is for the Object value type
+func encodeSetValue(valueType string, value interface{}) (interface{}, error) {
+	switch valueType {
+	case common.ValueTypeObject:
+		if _, err := json.Marshal(value); err != nil {
+			return nil, err
+		}
+		return value, nil
+	case common.ValueTypeBinary:
+		return nil, fmt.Errorf("the %s value type is not supported by SET commands", valueType)
+	}
+
+	var encoded string
+	if s, ok := value.(string); ok {
+		encoded = s
+	} else if strings.HasSuffix(valueType, "Array") {
+		elementType := strings.TrimSuffix(valueType, "Array")
+		v := reflect.ValueOf(value)
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return nil, fmt.Errorf("expected a slice but got %T", value)
+		}
+		elements := make([]string, v.Len())
+		for i := range elements {
+			element, err := encodeSimpleValue(elementType, v.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			// The elements are not escaped, the device services splitting the array on commas and trimming the brackets
+			if elementType == common.ValueTypeString && strings.ContainsAny(element, ",[]") {
+				return nil, fmt.Errorf("the array element %q holds a comma or a square bracket", element)
+			}
+			elements[i] = element
+		}
+		encoded = "[" + strings.Join(elements, ", ") + "]"
+	} else {
+		var err error
+		if encoded, err = encodeSimpleValue(valueType, value); err != nil {
+			return nil, err
+		}
+	}
+
+	if strings.HasSuffix(valueType, "Array") && (!strings.HasPrefix(encoded, "[") || !strings.HasSuffix(encoded, "]")) {
+		return nil, fmt.Errorf("%s is not enclosed in square brackets", encoded)
+	}
+	// An empty array is a valid value of the array value types, but ValidateValue parses it as one empty element
+	if encoded == "[]" {
+		return encoded, nil
+	}
+	if err := dtos.ValidateValue(valueType, encoded); err != nil {
+		return nil, err
+	}
+	return encoded, nil
+}
+
+// encodeSimpleValue encodes a value of a simple value type, accepting the Go values of any kind compatible with it
+func encodeSimpleValue(valueType string, value interface{}) (string, error) {
+	v := reflect.ValueOf(value)
+	switch valueType {
+	case common.ValueTypeBool:
+		if v.Kind() == reflect.Bool {
+			return strconv.FormatBool(v.Bool()), nil
+		}
+	case common.ValueTypeString:
+		if v.Kind() == reflect.String {
+			return v.String(), nil
+		}
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64,
+		common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64:
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return strconv.FormatInt(v.Int(), 10), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return strconv.FormatUint(v.Uint(), 10), nil
+		}
+	case common.ValueTypeFloat32, common.ValueTypeFloat64:
+		switch v.Kind() {
+		case reflect.Float32:
+			return strconv.FormatFloat(v.Float(), 'g', -1, 32), nil
+		case reflect.Float64:
+			return strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return strconv.FormatInt(v.Int(), 10), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return strconv.FormatUint(v.Uint(), 10), nil
+		}
+	default:
+		return "", fmt.Errorf("unknown value type %s", valueType)
+	}
+	return "", fmt.Errorf("a %T value cannot be converted to %s", value, valueType)
+}
@@ -0,0 +1,121 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+func TestEncodeSetValue(t *testing.T) {
+	tests := []struct {
+		name          string
+		valueType     string
+		value         interface{}
+		expected      interface{}
+		expectedError bool
+	}{
+		{"valid, bool", common.ValueTypeBool, true, "true", false},
+		{"valid, bool string", common.ValueTypeBool, "false", "false", false},
+		{"valid, string", common.ValueTypeString, "on", "on", false},
+		{"valid, uint8 from int", common.ValueTypeUint8, 255, "255", false},
+		{"valid, int16 from uint8", common.ValueTypeInt16, uint8(10), "10", false},
+		{"valid, int64 string", common.ValueTypeInt64, "-42", "-42", false},
+		{"valid, float32", common.ValueTypeFloat32, float32(1.5), "1.5", false},
+		{"valid, float64 from int", common.ValueTypeFloat64, 3, "3", false},
+		{"valid, float64 precision", common.ValueTypeFloat64, 0.1234567891234, "0.1234567891234", false},
+		{"valid, int32 array", common.ValueTypeInt32Array, []int{1, -2, 3}, "[1, -2, 3]", false},
+		{"valid, float64 array", common.ValueTypeFloat64Array, [2]float64{1.5, 2}, "[1.5, 2]", false},
+		{"valid, bool array string", common.ValueTypeBoolArray, "[true, false]", "[true, false]", false},
+		{"valid, string array", common.ValueTypeStringArray, []string{"a", "b"}, "[a, b]", false},
+		{"valid, empty int8 array", common.ValueTypeInt8Array, []int{}, "[]", false},
+		{"valid, empty float32 array string", common.ValueTypeFloat32Array, "[]", "[]", false},
+		{"valid, empty bool array", common.ValueTypeBoolArray, []bool{}, "[]", false},
+		{"valid, empty string array", common.ValueTypeStringArray, []string{}, "[]", false},
+		{"valid, object", common.ValueTypeObject, map[string]interface{}{"mode": "eco"}, map[string]interface{}{"mode": "eco"}, false},
+		{"invalid, uint8 out of range", common.ValueTypeUint8, 256, nil, true},
+		{"invalid, negative uint16", common.ValueTypeUint16, -1, nil, true},
+		{"invalid, int from float", common.ValueTypeInt32, 1.5, nil, true},
+		{"invalid, float32 out of range", common.ValueTypeFloat32, 1e39, nil, true},
+		{"invalid, bool from int", common.ValueTypeBool, 1, nil, true},
+		{"invalid, bool string", common.ValueTypeBool, "yes", nil, true},
+		{"invalid, int8 array element", common.ValueTypeInt8Array, []int{1, 128}, nil, true},
+		{"invalid, string array element with a comma", common.ValueTypeStringArray, []string{"a", "b, c"}, nil, true},
+		{"invalid, string array element with brackets", common.ValueTypeStringArray, []string{"[a]"}, nil, true},
+		{"invalid, array from scalar", common.ValueTypeInt8Array, 1, nil, true},
+		{"invalid, array string without brackets", common.ValueTypeInt8Array, "1, 2", nil, true},
+		{"invalid, empty array string", common.ValueTypeInt8Array, "", nil, true},
+		{"invalid, binary", common.ValueTypeBinary, []byte{1}, nil, true},
+		{"invalid, object", common.ValueTypeObject, make(chan int), nil, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := encodeSetValue(testCase.valueType, testCase.value)
+			if testCase.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
+
+func TestSetCommandBuilder(t *testing.T) {
+	command := dtos.CoreCommand{
+		Name: TestCommandName,
+		Set:  true,
+		Parameters: []dtos.CoreCommandParameter{
+			{ResourceName: "Switch", ValueType: common.ValueTypeBool},
+			{ResourceName: "Level", ValueType: common.ValueTypeUint8},
+			{ResourceName: "Config", ValueType: common.ValueTypeObject},
+		},
+	}
+	config := map[string]interface{}{"mode": "eco"}
+
+	builder := NewSetCommandBuilder(command).Set("Switch", true).Set("Level", 80).Set("Config", config)
+	object, err := builder.Object()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"Switch": "true", "Level": "80", "Config": config}, object)
+	settings, err := builder.Settings()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Switch": "true", "Level": "80", "Config": `{"mode":"eco"}`}, settings)
+
+	tests := []struct {
+		name     string
+		command  dtos.CoreCommand
+		values   map[string]interface{}
+		messages []string
+	}{
+		{"unknown resource", command, map[string]interface{}{"Switch": true, "Level": 1, "Config": config, "Color": "red"}, []string{"resource Color is not a parameter"}},
+		{"missing parameter", command, map[string]interface{}{"Switch": true}, []string{"parameter Level", "parameter Config"}},
+		{"invalid value", command, map[string]interface{}{"Switch": true, "Level": 300, "Config": config}, []string{"invalid Uint8 value of resource Level"}},
+		{"string array element with a separator", dtos.CoreCommand{Name: TestCommandName, Set: true, Parameters: []dtos.CoreCommandParameter{{ResourceName: "Modes", ValueType: common.ValueTypeStringArray}}}, map[string]interface{}{"Modes": []string{"eco", "a,b"}}, []string{"invalid StringArray value of resource Modes"}},
+		{"not a SET command", dtos.CoreCommand{Name: TestCommandName, Get: true}, nil, []string{"does not support SET"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			builder := NewSetCommandBuilder(testCase.command)
+			for resourceName, value := range testCase.values {
+				builder.Set(resourceName, value)
+			}
+			_, err := builder.Object()
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			for _, message := range testCase.messages {
+				assert.Contains(t, err.Error(), message)
+			}
+			_, err = builder.Settings()
+			assert.Error(t, err)
+		})
+	}
+}
@@ -8,9 +8,9 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -21,12 +21,14 @@ import (
 
 type CommandClient struct {
 	baseUrl string
+	opts    []utils.ClientOption
 }
 
 // NewCommandClient creates an instance of CommandClient
-func NewCommandClient(baseUrl string) interfaces.CommandClient {
+func NewCommandClient(baseUrl string, opts ...utils.ClientOption) interfaces.CommandClient {
 	return &CommandClient{
 		baseUrl: baseUrl,
+		opts:    opts,
 	}
 }
 
@@ -36,7 +38,7 @@ func (client *CommandClient) AllDeviceCoreCommands(ctx context.Context, offset i
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllDeviceRoute, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllDeviceRoute, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -46,8 +48,11 @@ func (client *CommandClient) AllDeviceCoreCommands(ctx context.Context, offset i
 // DeviceCoreCommandsByDeviceName returns all commands associated with the specified device name.
 func (client *CommandClient) DeviceCoreCommandsByDeviceName(ctx context.Context, name string) (
 	res responses.DeviceCoreCommandResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiDeviceRoute, common.Name, name)
-	err = utils.GetRequest(ctx, &res, client.baseUrl, path, nil)
+	requestPath, err := routes.Build(common.ApiDeviceByNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -59,8 +64,11 @@ func (client *CommandClient) IssueGetCommandByName(ctx context.Context, deviceNa
 	requestParams := url.Values{}
 	requestParams.Set(common.PushEvent, strconv.FormatBool(dsPushEvent))
 	requestParams.Set(common.ReturnEvent, strconv.FormatBool(dsReturnEvent))
-	requestPath := path.Join(common.ApiDeviceRoute, common.Name, deviceName, commandName)
-	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	requestPath, err := routes.Build(common.ApiDeviceNameCommandNameRoute, map[string]string{common.Name: deviceName, common.Command: commandName})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -73,8 +81,11 @@ func (client *CommandClient) IssueGetCommandByNameWithQueryParams(ctx context.Co
 		requestParams.Set(k, v)
 	}
 
-	requestPath := path.Join(common.ApiDeviceRoute, common.Name, url.QueryEscape(deviceName), url.QueryEscape(commandName))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	requestPath, err := routes.Build(common.ApiDeviceNameCommandNameRoute, map[string]string{common.Name: deviceName, common.Command: commandName})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -83,8 +94,11 @@ func (client *CommandClient) IssueGetCommandByNameWithQueryParams(ctx context.Co
 
 // IssueSetCommandByName issues the specified write command referenced by the command name to the device/sensor that is also referenced by name.
 func (client *CommandClient) IssueSetCommandByName(ctx context.Context, deviceName string, commandName string, settings map[string]string) (res dtoCommon.BaseResponse, err errors.EdgeX) {
-	requestPath := path.Join(common.ApiDeviceRoute, common.Name, deviceName, commandName)
-	err = utils.PutRequest(ctx, &res, client.baseUrl, requestPath, nil, settings)
+	requestPath, err := routes.Build(common.ApiDeviceNameCommandNameRoute, map[string]string{common.Name: deviceName, common.Command: commandName})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.PutRequest(ctx, &res, client.baseUrl, requestPath, nil, settings, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -93,8 +107,11 @@ func (client *CommandClient) IssueSetCommandByName(ctx context.Context, deviceNa
 
 // IssueSetCommandByNameWithObject issues the specified write command and the settings supports object value type
 func (client *CommandClient) IssueSetCommandByNameWithObject(ctx context.Context, deviceName string, commandName string, settings map[string]interface{}) (res dtoCommon.BaseResponse, err errors.EdgeX) {
-	requestPath := path.Join(common.ApiDeviceRoute, common.Name, deviceName, commandName)
-	err = utils.PutRequest(ctx, &res, client.baseUrl, requestPath, nil, settings)
+	requestPath, err := routes.Build(common.ApiDeviceNameCommandNameRoute, map[string]string{common.Name: deviceName, common.Command: commandName})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.PutRequest(ctx, &res, client.baseUrl, requestPath, nil, settings, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
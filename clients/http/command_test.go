@@ -113,3 +113,23 @@ func TestIssueIssueSetCommandByNameWithObject(t *testing.T) {
 	require.NoError(t, err)
 	require.IsType(t, dtoCommon.BaseResponse{}, res)
 }
+
+func TestIssueCommandByNameEscapesNames(t *testing.T) {
+	deviceName := "Simple Device/01"
+	cmdName := "Switch+Button"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != common.ApiDeviceRoute+"/name/Simple%20Device%2F01/Switch%2BButton" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer ts.Close()
+
+	client := NewCommandClient(ts.URL)
+	_, err := client.IssueGetCommandByName(context.Background(), deviceName, cmdName, false, true)
+	require.NoError(t, err)
+	_, err = client.IssueSetCommandByName(context.Background(), deviceName, cmdName, map[string]string{"SwitchButton": "true"})
+	require.NoError(t, err)
+}
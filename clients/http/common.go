@@ -17,18 +17,20 @@ import (
 
 type commonClient struct {
 	baseUrl string
+	opts    []utils.ClientOption
 }
 
 // NewCommonClient creates an instance of CommonClient
-func NewCommonClient(baseUrl string) interfaces.CommonClient {
+func NewCommonClient(baseUrl string, opts ...utils.ClientOption) interfaces.CommonClient {
 	return &commonClient{
 		baseUrl: baseUrl,
+		opts:    opts,
 	}
 }
 
 func (cc *commonClient) Configuration(ctx context.Context) (dtoCommon.ConfigResponse, errors.EdgeX) {
 	cr := dtoCommon.ConfigResponse{}
-	err := utils.GetRequest(ctx, &cr, cc.baseUrl, common.ApiConfigRoute, nil)
+	err := utils.GetRequest(ctx, &cr, cc.baseUrl, common.ApiConfigRoute, nil, cc.opts...)
 	if err != nil {
 		return cr, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -37,7 +39,7 @@ func (cc *commonClient) Configuration(ctx context.Context) (dtoCommon.ConfigResp
 
 func (cc *commonClient) Ping(ctx context.Context) (dtoCommon.PingResponse, errors.EdgeX) {
 	pr := dtoCommon.PingResponse{}
-	err := utils.GetRequest(ctx, &pr, cc.baseUrl, common.ApiPingRoute, nil)
+	err := utils.GetRequest(ctx, &pr, cc.baseUrl, common.ApiPingRoute, nil, cc.opts...)
 	if err != nil {
 		return pr, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -46,7 +48,7 @@ func (cc *commonClient) Ping(ctx context.Context) (dtoCommon.PingResponse, error
 
 func (cc *commonClient) Version(ctx context.Context) (dtoCommon.VersionResponse, errors.EdgeX) {
 	vr := dtoCommon.VersionResponse{}
-	err := utils.GetRequest(ctx, &vr, cc.baseUrl, common.ApiVersionRoute, nil)
+	err := utils.GetRequest(ctx, &vr, cc.baseUrl, common.ApiVersionRoute, nil, cc.opts...)
 	if err != nil {
 		return vr, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -54,7 +56,7 @@ func (cc *commonClient) Version(ctx context.Context) (dtoCommon.VersionResponse,
 }
 
 func (cc *commonClient) AddSecret(ctx context.Context, request dtoCommon.SecretRequest) (res dtoCommon.BaseResponse, err errors.EdgeX) {
-	err = utils.PostRequestWithRawData(ctx, &res, cc.baseUrl, common.ApiSecretRoute, nil, request)
+	err = utils.PostRequestWithRawData(ctx, &res, cc.baseUrl, common.ApiSecretRoute, nil, request, cc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -3,10 +3,10 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 	"strings"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -18,17 +18,19 @@ import (
 
 type DeviceClient struct {
 	baseUrl string
+	opts    []utils.ClientOption
 }
 
 // NewDeviceClient creates an instance of DeviceClient
-func NewDeviceClient(baseUrl string) interfaces.DeviceClient {
+func NewDeviceClient(baseUrl string, opts ...utils.ClientOption) interfaces.DeviceClient {
 	return &DeviceClient{
 		baseUrl: baseUrl,
+		opts:    opts,
 	}
 }
 
 func (dc DeviceClient) Add(ctx context.Context, reqs []requests.AddDeviceRequest) (res []dtoCommon.BaseWithIdResponse, err errors.EdgeX) {
-	err = utils.PostRequestWithRawData(ctx, &res, dc.baseUrl, common.ApiDeviceRoute, nil, reqs)
+	err = utils.PostRequestWithRawData(ctx, &res, dc.baseUrl, common.ApiDeviceRoute, nil, reqs, dc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -36,7 +38,7 @@ func (dc DeviceClient) Add(ctx context.Context, reqs []requests.AddDeviceRequest
 }
 
 func (dc DeviceClient) Update(ctx context.Context, reqs []requests.UpdateDeviceRequest) (res []dtoCommon.BaseResponse, err errors.EdgeX) {
-	err = utils.PatchRequest(ctx, &res, dc.baseUrl, common.ApiDeviceRoute, nil, reqs)
+	err = utils.PatchRequest(ctx, &res, dc.baseUrl, common.ApiDeviceRoute, nil, reqs, dc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -50,7 +52,7 @@ func (dc DeviceClient) AllDevices(ctx context.Context, labels []string, offset i
 	}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, dc.baseUrl, common.ApiAllDeviceRoute, requestParams)
+	err = utils.GetRequest(ctx, &res, dc.baseUrl, common.ApiAllDeviceRoute, requestParams, dc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -58,8 +60,23 @@ func (dc DeviceClient) AllDevices(ctx context.Context, labels []string, offset i
 }
 
 func (dc DeviceClient) DeviceNameExists(ctx context.Context, name string) (res dtoCommon.BaseResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiDeviceRoute, common.Check, common.Name, name)
-	err = utils.GetRequest(ctx, &res, dc.baseUrl, path, nil)
+	requestPath, err := routes.Build(common.ApiDeviceNameExistsRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, dc.baseUrl, requestPath, nil, dc.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+func (dc DeviceClient) DeviceIdExists(ctx context.Context, id string) (res dtoCommon.BaseResponse, err errors.EdgeX) {
+	requestPath, err := routes.Build(common.ApiDeviceIdExistsRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, dc.baseUrl, requestPath, nil, dc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -67,8 +84,23 @@ func (dc DeviceClient) DeviceNameExists(ctx context.Context, name string) (res d
 }
 
 func (dc DeviceClient) DeviceByName(ctx context.Context, name string) (res responses.DeviceResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiDeviceRoute, common.Name, name)
-	err = utils.GetRequest(ctx, &res, dc.baseUrl, path, nil)
+	requestPath, err := routes.Build(common.ApiDeviceByNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, dc.baseUrl, requestPath, nil, dc.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+func (dc DeviceClient) DeviceById(ctx context.Context, id string) (res responses.DeviceResponse, err errors.EdgeX) {
+	requestPath, err := routes.Build(common.ApiDeviceByIdRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, dc.baseUrl, requestPath, nil, dc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -76,8 +108,23 @@ func (dc DeviceClient) DeviceByName(ctx context.Context, name string) (res respo
 }
 
 func (dc DeviceClient) DeleteDeviceByName(ctx context.Context, name string) (res dtoCommon.BaseResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiDeviceRoute, common.Name, name)
-	err = utils.DeleteRequest(ctx, &res, dc.baseUrl, path)
+	requestPath, err := routes.Build(common.ApiDeviceByNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, dc.baseUrl, requestPath, dc.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+func (dc DeviceClient) DeleteDeviceById(ctx context.Context, id string) (res dtoCommon.BaseResponse, err errors.EdgeX) {
+	requestPath, err := routes.Build(common.ApiDeviceByIdRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, dc.baseUrl, requestPath, dc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -85,11 +132,29 @@ func (dc DeviceClient) DeleteDeviceByName(ctx context.Context, name string) (res
 }
 
 func (dc DeviceClient) DevicesByProfileName(ctx context.Context, name string, offset int, limit int) (res responses.MultiDevicesResponse, err errors.EdgeX) {
-	requestPath := path.Join(common.ApiDeviceRoute, common.Profile, common.Name, name)
+	requestPath, err := routes.Build(common.ApiDeviceByProfileNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, dc.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, dc.baseUrl, requestPath, requestParams, dc.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+func (dc DeviceClient) DevicesByProfileId(ctx context.Context, id string, offset int, limit int) (res responses.MultiDevicesResponse, err errors.EdgeX) {
+	requestPath, err := routes.Build(common.ApiDeviceByProfileIdRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	err = utils.GetRequest(ctx, &res, dc.baseUrl, requestPath, requestParams, dc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -97,11 +162,29 @@ func (dc DeviceClient) DevicesByProfileName(ctx context.Context, name string, of
 }
 
 func (dc DeviceClient) DevicesByServiceName(ctx context.Context, name string, offset int, limit int) (res responses.MultiDevicesResponse, err errors.EdgeX) {
-	requestPath := path.Join(common.ApiDeviceRoute, common.Service, common.Name, name)
+	requestPath, err := routes.Build(common.ApiDeviceByServiceNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	err = utils.GetRequest(ctx, &res, dc.baseUrl, requestPath, requestParams, dc.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+func (dc DeviceClient) DevicesByServiceId(ctx context.Context, id string, offset int, limit int) (res responses.MultiDevicesResponse, err errors.EdgeX) {
+	requestPath, err := routes.Build(common.ApiDeviceByServiceIdRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, dc.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, dc.baseUrl, requestPath, requestParams, dc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
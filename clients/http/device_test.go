@@ -52,6 +52,16 @@ func TestDeviceNameExists(t *testing.T) {
 	require.IsType(t, dtoCommon.BaseResponse{}, res)
 }
 
+func TestDeviceIdExists(t *testing.T) {
+	path := path.Join(common.ApiDeviceRoute, common.Check, common.Id, ExampleUUID)
+	ts := newTestServer(http.MethodGet, path, dtoCommon.BaseResponse{})
+	defer ts.Close()
+	client := NewDeviceClient(ts.URL)
+	res, err := client.DeviceIdExists(context.Background(), ExampleUUID)
+	require.NoError(t, err)
+	require.IsType(t, dtoCommon.BaseResponse{}, res)
+}
+
 func TestQueryDeviceByName(t *testing.T) {
 	deviceName := "device"
 	path := path.Join(common.ApiDeviceRoute, common.Name, deviceName)
@@ -63,6 +73,16 @@ func TestQueryDeviceByName(t *testing.T) {
 	require.IsType(t, responses.DeviceResponse{}, res)
 }
 
+func TestQueryDeviceById(t *testing.T) {
+	path := path.Join(common.ApiDeviceRoute, common.Id, ExampleUUID)
+	ts := newTestServer(http.MethodGet, path, responses.DeviceResponse{})
+	defer ts.Close()
+	client := NewDeviceClient(ts.URL)
+	res, err := client.DeviceById(context.Background(), ExampleUUID)
+	require.NoError(t, err)
+	require.IsType(t, responses.DeviceResponse{}, res)
+}
+
 func TestDeleteDeviceByName(t *testing.T) {
 	deviceName := "device"
 	path := path.Join(common.ApiDeviceRoute, common.Name, deviceName)
@@ -74,6 +94,16 @@ func TestDeleteDeviceByName(t *testing.T) {
 	require.IsType(t, dtoCommon.BaseResponse{}, res)
 }
 
+func TestDeleteDeviceById(t *testing.T) {
+	path := path.Join(common.ApiDeviceRoute, common.Id, ExampleUUID)
+	ts := newTestServer(http.MethodDelete, path, dtoCommon.BaseResponse{})
+	defer ts.Close()
+	client := NewDeviceClient(ts.URL)
+	res, err := client.DeleteDeviceById(context.Background(), ExampleUUID)
+	require.NoError(t, err)
+	require.IsType(t, dtoCommon.BaseResponse{}, res)
+}
+
 func TestQueryDevicesByProfileName(t *testing.T) {
 	profileName := "profile"
 	urlPath := path.Join(common.ApiDeviceRoute, common.Profile, common.Name, profileName)
@@ -95,3 +125,23 @@ func TestQueryDevicesByServiceName(t *testing.T) {
 	require.NoError(t, err)
 	require.IsType(t, responses.MultiDevicesResponse{}, res)
 }
+
+func TestQueryDevicesByProfileId(t *testing.T) {
+	urlPath := path.Join(common.ApiDeviceRoute, common.Profile, common.Id, ExampleUUID)
+	ts := newTestServer(http.MethodGet, urlPath, responses.MultiDevicesResponse{})
+	defer ts.Close()
+	client := NewDeviceClient(ts.URL)
+	res, err := client.DevicesByProfileId(context.Background(), ExampleUUID, 1, 10)
+	require.NoError(t, err)
+	require.IsType(t, responses.MultiDevicesResponse{}, res)
+}
+
+func TestQueryDevicesByServiceId(t *testing.T) {
+	urlPath := path.Join(common.ApiDeviceRoute, common.Service, common.Id, ExampleUUID)
+	ts := newTestServer(http.MethodGet, urlPath, responses.MultiDevicesResponse{})
+	defer ts.Close()
+	client := NewDeviceClient(ts.URL)
+	res, err := client.DevicesByServiceId(context.Background(), ExampleUUID, 1, 10)
+	require.NoError(t, err)
+	require.IsType(t, responses.MultiDevicesResponse{}, res)
+}
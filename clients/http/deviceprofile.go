@@ -9,11 +9,11 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"path"
 	"strconv"
 	"strings"
 	"sync"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -25,14 +25,16 @@ import (
 
 type DeviceProfileClient struct {
 	baseUrl        string
+	opts           []utils.ClientOption
 	resourcesCache map[string]responses.DeviceResourceResponse
 	mux            sync.RWMutex
 }
 
 // NewDeviceProfileClient creates an instance of DeviceProfileClient
-func NewDeviceProfileClient(baseUrl string) interfaces.DeviceProfileClient {
+func NewDeviceProfileClient(baseUrl string, opts ...utils.ClientOption) interfaces.DeviceProfileClient {
 	return &DeviceProfileClient{
 		baseUrl:        baseUrl,
+		opts:           opts,
 		resourcesCache: make(map[string]responses.DeviceResourceResponse),
 	}
 }
@@ -40,7 +42,7 @@ func NewDeviceProfileClient(baseUrl string) interfaces.DeviceProfileClient {
 // Add adds new device profile
 func (client *DeviceProfileClient) Add(ctx context.Context, reqs []requests.DeviceProfileRequest) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
 	var responses []dtoCommon.BaseWithIdResponse
-	err := utils.PostRequestWithRawData(ctx, &responses, client.baseUrl, common.ApiDeviceProfileRoute, nil, reqs)
+	err := utils.PostRequestWithRawData(ctx, &responses, client.baseUrl, common.ApiDeviceProfileRoute, nil, reqs, client.opts...)
 	if err != nil {
 		return responses, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -50,7 +52,7 @@ func (client *DeviceProfileClient) Add(ctx context.Context, reqs []requests.Devi
 // Update updates device profile
 func (client *DeviceProfileClient) Update(ctx context.Context, reqs []requests.DeviceProfileRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
 	var responses []dtoCommon.BaseResponse
-	err := utils.PutRequest(ctx, &responses, client.baseUrl, common.ApiDeviceProfileRoute, nil, reqs)
+	err := utils.PutRequest(ctx, &responses, client.baseUrl, common.ApiDeviceProfileRoute, nil, reqs, client.opts...)
 	if err != nil {
 		return responses, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -60,7 +62,7 @@ func (client *DeviceProfileClient) Update(ctx context.Context, reqs []requests.D
 // AddByYaml adds new device profile by uploading a yaml file
 func (client *DeviceProfileClient) AddByYaml(ctx context.Context, yamlFilePath string) (dtoCommon.BaseWithIdResponse, errors.EdgeX) {
 	var responses dtoCommon.BaseWithIdResponse
-	err := utils.PostByFileRequest(ctx, &responses, client.baseUrl, common.ApiDeviceProfileUploadFileRoute, yamlFilePath)
+	err := utils.PostByFileRequest(ctx, &responses, client.baseUrl, common.ApiDeviceProfileUploadFileRoute, yamlFilePath, client.opts...)
 	if err != nil {
 		return responses, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -70,7 +72,7 @@ func (client *DeviceProfileClient) AddByYaml(ctx context.Context, yamlFilePath s
 // UpdateByYaml updates device profile by uploading a yaml file
 func (client *DeviceProfileClient) UpdateByYaml(ctx context.Context, yamlFilePath string) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var responses dtoCommon.BaseResponse
-	err := utils.PutByFileRequest(ctx, &responses, client.baseUrl, common.ApiDeviceProfileUploadFileRoute, yamlFilePath)
+	err := utils.PutByFileRequest(ctx, &responses, client.baseUrl, common.ApiDeviceProfileUploadFileRoute, yamlFilePath, client.opts...)
 	if err != nil {
 		return responses, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -80,8 +82,25 @@ func (client *DeviceProfileClient) UpdateByYaml(ctx context.Context, yamlFilePat
 // DeleteByName deletes the device profile by name
 func (client *DeviceProfileClient) DeleteByName(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
-	requestPath := path.Join(common.ApiDeviceProfileRoute, common.Name, name)
-	err := utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
+	requestPath, err := routes.Build(common.ApiDeviceProfileByNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath, client.opts...)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	return response, nil
+}
+
+// DeleteById deletes the device profile by id
+func (client *DeviceProfileClient) DeleteById(ctx context.Context, id string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	var response dtoCommon.BaseResponse
+	requestPath, err := routes.Build(common.ApiDeviceProfileByIdRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath, client.opts...)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -90,8 +109,24 @@ func (client *DeviceProfileClient) DeleteByName(ctx context.Context, name string
 
 // DeviceProfileByName queries the device profile by name
 func (client *DeviceProfileClient) DeviceProfileByName(ctx context.Context, name string) (res responses.DeviceProfileResponse, edgexError errors.EdgeX) {
-	requestPath := path.Join(common.ApiDeviceProfileRoute, common.Name, name)
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil)
+	requestPath, err := routes.Build(common.ApiDeviceProfileByNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil, client.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// DeviceProfileById queries the device profile by id
+func (client *DeviceProfileClient) DeviceProfileById(ctx context.Context, id string) (res responses.DeviceProfileResponse, edgexError errors.EdgeX) {
+	requestPath, err := routes.Build(common.ApiDeviceProfileByIdRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -106,7 +141,7 @@ func (client *DeviceProfileClient) AllDeviceProfiles(ctx context.Context, labels
 	}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllDeviceProfileRoute, requestParams)
+	err := utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllDeviceProfileRoute, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -115,11 +150,14 @@ func (client *DeviceProfileClient) AllDeviceProfiles(ctx context.Context, labels
 
 // DeviceProfilesByModel queries the device profiles with offset, limit and model
 func (client *DeviceProfileClient) DeviceProfilesByModel(ctx context.Context, model string, offset int, limit int) (res responses.MultiDeviceProfilesResponse, edgexError errors.EdgeX) {
-	requestPath := path.Join(common.ApiDeviceProfileRoute, common.Model, model)
+	requestPath, err := routes.Build(common.ApiDeviceProfileByModelRoute, map[string]string{common.Model: model})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -128,11 +166,14 @@ func (client *DeviceProfileClient) DeviceProfilesByModel(ctx context.Context, mo
 
 // DeviceProfilesByManufacturer queries the device profiles with offset, limit and manufacturer
 func (client *DeviceProfileClient) DeviceProfilesByManufacturer(ctx context.Context, manufacturer string, offset int, limit int) (res responses.MultiDeviceProfilesResponse, edgexError errors.EdgeX) {
-	requestPath := path.Join(common.ApiDeviceProfileRoute, common.Manufacturer, manufacturer)
+	requestPath, err := routes.Build(common.ApiDeviceProfileByManufacturerRoute, map[string]string{common.Manufacturer: manufacturer})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -141,11 +182,14 @@ func (client *DeviceProfileClient) DeviceProfilesByManufacturer(ctx context.Cont
 
 // DeviceProfilesByManufacturerAndModel queries the device profiles with offset, limit, manufacturer and model
 func (client *DeviceProfileClient) DeviceProfilesByManufacturerAndModel(ctx context.Context, manufacturer string, model string, offset int, limit int) (res responses.MultiDeviceProfilesResponse, edgexError errors.EdgeX) {
-	requestPath := path.Join(common.ApiDeviceProfileRoute, common.Manufacturer, manufacturer, common.Model, model)
+	requestPath, err := routes.Build(common.ApiDeviceProfileByManufacturerAndModelRoute, map[string]string{common.Manufacturer: manufacturer, common.Model: model})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -159,8 +203,11 @@ func (client *DeviceProfileClient) DeviceResourceByProfileNameAndResourceName(ct
 	if exists {
 		return res, nil
 	}
-	requestPath := path.Join(common.ApiDeviceResourceRoute, common.Profile, profileName, common.Resource, resourceName)
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil)
+	requestPath, err := routes.Build(common.ApiDeviceResourceByProfileAndResourceRoute, map[string]string{common.ProfileName: profileName, common.ResourceName: resourceName})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -190,7 +237,7 @@ func (client *DeviceProfileClient) CleanResourcesCache() {
 // UpdateDeviceProfileBasicInfo updates existing profile's basic info
 func (client *DeviceProfileClient) UpdateDeviceProfileBasicInfo(ctx context.Context, reqs []requests.DeviceProfileBasicInfoRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
 	var responses []dtoCommon.BaseResponse
-	err := utils.PatchRequest(ctx, &responses, client.baseUrl, common.ApiDeviceProfileBasicInfoRoute, nil, reqs)
+	err := utils.PatchRequest(ctx, &responses, client.baseUrl, common.ApiDeviceProfileBasicInfoRoute, nil, reqs, client.opts...)
 	if err != nil {
 		return responses, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -200,7 +247,7 @@ func (client *DeviceProfileClient) UpdateDeviceProfileBasicInfo(ctx context.Cont
 // AddDeviceProfileResource adds new device resource to an existing profile
 func (client *DeviceProfileClient) AddDeviceProfileResource(ctx context.Context, reqs []requests.AddDeviceResourceRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
 	var responses []dtoCommon.BaseResponse
-	err := utils.PostRequestWithRawData(ctx, &responses, client.baseUrl, common.ApiDeviceProfileResourceRoute, nil, reqs)
+	err := utils.PostRequestWithRawData(ctx, &responses, client.baseUrl, common.ApiDeviceProfileResourceRoute, nil, reqs, client.opts...)
 	if err != nil {
 		return responses, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -210,7 +257,7 @@ func (client *DeviceProfileClient) AddDeviceProfileResource(ctx context.Context,
 // UpdateDeviceProfileResource updates existing device resource
 func (client *DeviceProfileClient) UpdateDeviceProfileResource(ctx context.Context, reqs []requests.UpdateDeviceResourceRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
 	var responses []dtoCommon.BaseResponse
-	err := utils.PatchRequest(ctx, &responses, client.baseUrl, common.ApiDeviceProfileResourceRoute, nil, reqs)
+	err := utils.PatchRequest(ctx, &responses, client.baseUrl, common.ApiDeviceProfileResourceRoute, nil, reqs, client.opts...)
 	if err != nil {
 		return responses, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -220,8 +267,11 @@ func (client *DeviceProfileClient) UpdateDeviceProfileResource(ctx context.Conte
 // DeleteDeviceResourceByName deletes device resource by name
 func (client *DeviceProfileClient) DeleteDeviceResourceByName(ctx context.Context, profileName string, resourceName string) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
-	requestPath := path.Join(common.ApiDeviceProfileRoute, common.Name, url.QueryEscape(profileName), common.Resource, url.QueryEscape(resourceName))
-	err := utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
+	requestPath, err := routes.Build(common.ApiDeviceProfileResourceByNameRoute, map[string]string{common.Name: profileName, common.ResourceName: resourceName})
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath, client.opts...)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -231,7 +281,7 @@ func (client *DeviceProfileClient) DeleteDeviceResourceByName(ctx context.Contex
 // AddDeviceProfileDeviceCommand adds new device command to an existing profile
 func (client *DeviceProfileClient) AddDeviceProfileDeviceCommand(ctx context.Context, reqs []requests.AddDeviceCommandRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
 	var responses []dtoCommon.BaseResponse
-	err := utils.PostRequestWithRawData(ctx, &responses, client.baseUrl, common.ApiDeviceProfileDeviceCommandRoute, nil, reqs)
+	err := utils.PostRequestWithRawData(ctx, &responses, client.baseUrl, common.ApiDeviceProfileDeviceCommandRoute, nil, reqs, client.opts...)
 	if err != nil {
 		return responses, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -241,7 +291,7 @@ func (client *DeviceProfileClient) AddDeviceProfileDeviceCommand(ctx context.Con
 // UpdateDeviceProfileDeviceCommand updates existing device command
 func (client *DeviceProfileClient) UpdateDeviceProfileDeviceCommand(ctx context.Context, reqs []requests.UpdateDeviceCommandRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
 	var responses []dtoCommon.BaseResponse
-	err := utils.PatchRequest(ctx, &responses, client.baseUrl, common.ApiDeviceProfileDeviceCommandRoute, nil, reqs)
+	err := utils.PatchRequest(ctx, &responses, client.baseUrl, common.ApiDeviceProfileDeviceCommandRoute, nil, reqs, client.opts...)
 	if err != nil {
 		return responses, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -251,8 +301,11 @@ func (client *DeviceProfileClient) UpdateDeviceProfileDeviceCommand(ctx context.
 // DeleteDeviceCommandByName deletes device command by name
 func (client *DeviceProfileClient) DeleteDeviceCommandByName(ctx context.Context, profileName string, commandName string) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
-	requestPath := path.Join(common.ApiDeviceProfileRoute, common.Name, url.QueryEscape(profileName), common.DeviceCommand, url.QueryEscape(commandName))
-	err := utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
+	requestPath, err := routes.Build(common.ApiDeviceProfileDeviceCommandByNameRoute, map[string]string{common.Name: profileName, common.CommandName: commandName})
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath, client.opts...)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -179,6 +179,17 @@ func TestDeleteDeviceProfileByName(t *testing.T) {
 	require.NotNil(t, res)
 }
 
+func TestDeleteDeviceProfileById(t *testing.T) {
+	urlPath := path.Join(common.ApiDeviceProfileRoute, common.Id, ExampleUUID)
+	ts := newTestServer(http.MethodDelete, urlPath, dtoCommon.BaseResponse{})
+	defer ts.Close()
+
+	client := NewDeviceProfileClient(ts.URL)
+	res, err := client.DeleteById(context.Background(), ExampleUUID)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
 func TestQueryDeviceProfileByName(t *testing.T) {
 	testName := "testName"
 	urlPath := path.Join(common.ApiDeviceProfileRoute, common.Name, testName)
@@ -189,6 +200,15 @@ func TestQueryDeviceProfileByName(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestQueryDeviceProfileById(t *testing.T) {
+	urlPath := path.Join(common.ApiDeviceProfileRoute, common.Id, ExampleUUID)
+	ts := newTestServer(http.MethodGet, urlPath, responses.DeviceProfileResponse{})
+	defer ts.Close()
+	client := NewDeviceProfileClient(ts.URL)
+	_, err := client.DeviceProfileById(context.Background(), ExampleUUID)
+	require.NoError(t, err)
+}
+
 func TestQueryAllDeviceProfiles(t *testing.T) {
 	ts := newTestServer(http.MethodGet, common.ApiAllDeviceProfileRoute, responses.MultiDeviceProfilesResponse{})
 	defer ts.Close()
@@ -3,10 +3,10 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 	"strings"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -18,18 +18,20 @@ import (
 
 type DeviceServiceClient struct {
 	baseUrl string
+	opts    []utils.ClientOption
 }
 
 // NewDeviceServiceClient creates an instance of DeviceServiceClient
-func NewDeviceServiceClient(baseUrl string) interfaces.DeviceServiceClient {
+func NewDeviceServiceClient(baseUrl string, opts ...utils.ClientOption) interfaces.DeviceServiceClient {
 	return &DeviceServiceClient{
 		baseUrl: baseUrl,
+		opts:    opts,
 	}
 }
 
 func (dsc DeviceServiceClient) Add(ctx context.Context, reqs []requests.AddDeviceServiceRequest) (
 	res []dtoCommon.BaseWithIdResponse, err errors.EdgeX) {
-	err = utils.PostRequestWithRawData(ctx, &res, dsc.baseUrl, common.ApiDeviceServiceRoute, nil, reqs)
+	err = utils.PostRequestWithRawData(ctx, &res, dsc.baseUrl, common.ApiDeviceServiceRoute, nil, reqs, dsc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -38,7 +40,7 @@ func (dsc DeviceServiceClient) Add(ctx context.Context, reqs []requests.AddDevic
 
 func (dsc DeviceServiceClient) Update(ctx context.Context, reqs []requests.UpdateDeviceServiceRequest) (
 	res []dtoCommon.BaseResponse, err errors.EdgeX) {
-	err = utils.PatchRequest(ctx, &res, dsc.baseUrl, common.ApiDeviceServiceRoute, nil, reqs)
+	err = utils.PatchRequest(ctx, &res, dsc.baseUrl, common.ApiDeviceServiceRoute, nil, reqs, dsc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -53,7 +55,7 @@ func (dsc DeviceServiceClient) AllDeviceServices(ctx context.Context, labels []s
 	}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, dsc.baseUrl, common.ApiAllDeviceServiceRoute, requestParams)
+	err = utils.GetRequest(ctx, &res, dsc.baseUrl, common.ApiAllDeviceServiceRoute, requestParams, dsc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -62,8 +64,24 @@ func (dsc DeviceServiceClient) AllDeviceServices(ctx context.Context, labels []s
 
 func (dsc DeviceServiceClient) DeviceServiceByName(ctx context.Context, name string) (
 	res responses.DeviceServiceResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiDeviceServiceRoute, common.Name, name)
-	err = utils.GetRequest(ctx, &res, dsc.baseUrl, path, nil)
+	requestPath, err := routes.Build(common.ApiDeviceServiceByNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, dsc.baseUrl, requestPath, nil, dsc.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+func (dsc DeviceServiceClient) DeviceServiceById(ctx context.Context, id string) (
+	res responses.DeviceServiceResponse, err errors.EdgeX) {
+	requestPath, err := routes.Build(common.ApiDeviceServiceByIdRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, dsc.baseUrl, requestPath, nil, dsc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -72,8 +90,24 @@ func (dsc DeviceServiceClient) DeviceServiceByName(ctx context.Context, name str
 
 func (dsc DeviceServiceClient) DeleteByName(ctx context.Context, name string) (
 	res dtoCommon.BaseResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiDeviceServiceRoute, common.Name, name)
-	err = utils.DeleteRequest(ctx, &res, dsc.baseUrl, path)
+	requestPath, err := routes.Build(common.ApiDeviceServiceByNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, dsc.baseUrl, requestPath, dsc.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+func (dsc DeviceServiceClient) DeleteById(ctx context.Context, id string) (
+	res dtoCommon.BaseResponse, err errors.EdgeX) {
+	requestPath, err := routes.Build(common.ApiDeviceServiceByIdRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, dsc.baseUrl, requestPath, dsc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -59,6 +59,18 @@ func TestQueryDeviceServiceByName(t *testing.T) {
 	assert.IsType(t, responses.DeviceServiceResponse{}, res)
 }
 
+func TestQueryDeviceServiceById(t *testing.T) {
+	path := path.Join(common.ApiDeviceServiceRoute, common.Id, ExampleUUID)
+
+	ts := newTestServer(http.MethodGet, path, responses.DeviceServiceResponse{})
+	defer ts.Close()
+
+	client := NewDeviceServiceClient(ts.URL)
+	res, err := client.DeviceServiceById(context.Background(), ExampleUUID)
+	require.NoError(t, err)
+	assert.IsType(t, responses.DeviceServiceResponse{}, res)
+}
+
 func TestDeleteDeviceServiceByName(t *testing.T) {
 	deviceServiceName := "deviceService"
 	path := path.Join(common.ApiDeviceServiceRoute, common.Name, deviceServiceName)
@@ -71,3 +83,15 @@ func TestDeleteDeviceServiceByName(t *testing.T) {
 	require.NoError(t, err)
 	assert.IsType(t, dtoCommon.BaseResponse{}, res)
 }
+
+func TestDeleteDeviceServiceById(t *testing.T) {
+	path := path.Join(common.ApiDeviceServiceRoute, common.Id, ExampleUUID)
+
+	ts := newTestServer(http.MethodDelete, path, dtoCommon.BaseResponse{})
+	defer ts.Close()
+
+	client := NewDeviceServiceClient(ts.URL)
+	res, err := client.DeleteById(context.Background(), ExampleUUID)
+	require.NoError(t, err)
+	assert.IsType(t, dtoCommon.BaseResponse{}, res)
+}
@@ -9,8 +9,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/url"
-	"path"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -21,21 +21,29 @@ import (
 	"github.com/fxamacker/cbor/v2"
 )
 
-type deviceServiceCommandClient struct{}
+type deviceServiceCommandClient struct {
+	opts []utils.ClientOption
+}
 
-// NewDeviceServiceCommandClient creates an instance of deviceServiceCommandClient
-func NewDeviceServiceCommandClient() interfaces.DeviceServiceCommandClient {
-	return &deviceServiceCommandClient{}
+// NewDeviceServiceCommandClient creates an instance of deviceServiceCommandClient. The requests are sent to the base URL
+// given to each of them, so an endpoint resolver among the options is ignored.
+func NewDeviceServiceCommandClient(opts ...utils.ClientOption) interfaces.DeviceServiceCommandClient {
+	return &deviceServiceCommandClient{
+		opts: append(append([]utils.ClientOption(nil), opts...), utils.WithoutEndpointResolver()),
+	}
 }
 
 // GetCommand sends HTTP request to execute the Get command
 func (client *deviceServiceCommandClient) GetCommand(ctx context.Context, baseUrl string, deviceName string, commandName string, queryParams string) (*responses.EventResponse, errors.EdgeX) {
-	requestPath := path.Join(common.ApiDeviceRoute, common.Name, deviceName, commandName)
+	requestPath, edgexErr := routes.Build(common.ApiDeviceNameCommandNameRoute, map[string]string{common.Name: deviceName, common.Command: commandName})
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
 	params, err := url.ParseQuery(queryParams)
 	if err != nil {
 		return nil, errors.NewCommonEdgeXWrapper(err)
 	}
-	res, contentType, edgeXerr := utils.GetRequestAndReturnBinaryRes(ctx, baseUrl, requestPath, params)
+	res, contentType, edgeXerr := utils.GetRequestAndReturnBinaryRes(ctx, baseUrl, requestPath, params, client.opts...)
 	if edgeXerr != nil {
 		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
@@ -60,12 +68,15 @@ func (client *deviceServiceCommandClient) GetCommand(ctx context.Context, baseUr
 // SetCommand sends HTTP request to execute the Set command
 func (client *deviceServiceCommandClient) SetCommand(ctx context.Context, baseUrl string, deviceName string, commandName string, queryParams string, settings map[string]string) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
-	requestPath := path.Join(common.ApiDeviceRoute, common.Name, deviceName, commandName)
+	requestPath, edgexErr := routes.Build(common.ApiDeviceNameCommandNameRoute, map[string]string{common.Name: deviceName, common.Command: commandName})
+	if edgexErr != nil {
+		return response, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
 	params, err := url.ParseQuery(queryParams)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
-	err = utils.PutRequest(ctx, &response, baseUrl, requestPath, params, settings)
+	err = utils.PutRequest(ctx, &response, baseUrl, requestPath, params, settings, client.opts...)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -75,12 +86,15 @@ func (client *deviceServiceCommandClient) SetCommand(ctx context.Context, baseUr
 // SetCommandWithObject invokes device service's set command API and the settings supports object value type
 func (client *deviceServiceCommandClient) SetCommandWithObject(ctx context.Context, baseUrl string, deviceName string, commandName string, queryParams string, settings map[string]interface{}) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
-	requestPath := path.Join(common.ApiDeviceRoute, common.Name, deviceName, commandName)
+	requestPath, edgexErr := routes.Build(common.ApiDeviceNameCommandNameRoute, map[string]string{common.Name: deviceName, common.Command: commandName})
+	if edgexErr != nil {
+		return response, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
 	params, err := url.ParseQuery(queryParams)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
-	err = utils.PutRequest(ctx, &response, baseUrl, requestPath, params, settings)
+	err = utils.PutRequest(ctx, &response, baseUrl, requestPath, params, settings, client.opts...)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
@@ -54,6 +55,20 @@ func TestGetCommand(t *testing.T) {
 	assert.Equal(t, expectedResponse, *res)
 }
 
+func TestGetCommandIgnoresEndpointResolver(t *testing.T) {
+	requestId := uuid.New().String()
+	expectedResponse := responses.NewEventResponse(requestId, "", http.StatusOK, testEventDTO)
+	ts := newTestServer(http.MethodGet, common.ApiDeviceRoute+"/"+common.Name+"/"+TestDeviceName+"/"+TestCommandName, expectedResponse)
+	defer ts.Close()
+
+	// The resolver stands for another service, the request must still be sent to the base URL of the device service
+	client := NewDeviceServiceCommandClient(utils.WithEndpointResolver(utils.NewStaticEndpointResolver("http://localhost:59882"), 0))
+	res, err := client.GetCommand(context.Background(), ts.URL, TestDeviceName, TestCommandName, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, *res)
+}
+
 func TestSetCommand(t *testing.T) {
 	requestId := uuid.New().String()
 	expectedResponse := dtoCommon.NewBaseResponse(requestId, "", http.StatusOK)
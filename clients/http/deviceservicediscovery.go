@@ -0,0 +1,80 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+type deviceServiceDiscoveryClient struct {
+	baseUrl string
+	opts    []utils.ClientOption
+}
+
+// NewDeviceServiceDiscoveryClient creates an instance of deviceServiceDiscoveryClient
+func NewDeviceServiceDiscoveryClient(baseUrl string, opts ...utils.ClientOption) interfaces.DeviceServiceDiscoveryClient {
+	return &deviceServiceDiscoveryClient{
+		baseUrl: baseUrl,
+		opts:    opts,
+	}
+}
+
+func (client *deviceServiceDiscoveryClient) Discover(ctx context.Context) (dtoCommon.BaseResponse, errors.EdgeX) {
+	var response dtoCommon.BaseResponse
+	err := utils.PostRequest(ctx, &response, client.baseUrl, common.ApiDiscoveryRoute, nil, "", client.opts...)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	return response, nil
+}
+
+func (client *deviceServiceDiscoveryClient) DiscoveryStatus(ctx context.Context, requestId string) (responses.DiscoveryStatusResponse, errors.EdgeX) {
+	var response responses.DiscoveryStatusResponse
+	requestPath, err := routes.Build(common.ApiDiscoveryByIdRoute, map[string]string{common.RequestId: requestId})
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &response, client.baseUrl, requestPath, nil, client.opts...)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	return response, nil
+}
+
+func (client *deviceServiceDiscoveryClient) CancelDiscovery(ctx context.Context, requestId string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	var response dtoCommon.BaseResponse
+	requestPath, err := routes.Build(common.ApiDiscoveryByIdRoute, map[string]string{common.RequestId: requestId})
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath, client.opts...)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	return response, nil
+}
+
+func (client *deviceServiceDiscoveryClient) ValidateDevice(ctx context.Context, request requests.AddDeviceRequest) (responses.DeviceValidationResult, errors.EdgeX) {
+	var response dtoCommon.BaseResponse
+	err := utils.PostRequestWithRawData(ctx, &response, client.baseUrl, common.ApiDeviceValidationRoute, nil, request, client.opts...)
+	if err != nil {
+		// The device service rejects an invalid device with a BadRequest response
+		if errors.Kind(err) == errors.KindContractInvalid {
+			return responses.DeviceValidationResult{RequestId: request.RequestId, Message: err.Message()}, nil
+		}
+		return responses.DeviceValidationResult{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return responses.DeviceValidationResult{RequestId: response.RequestId, Valid: true, Message: response.Message}, nil
+}
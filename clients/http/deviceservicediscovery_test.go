@@ -0,0 +1,127 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+func TestDiscover(t *testing.T) {
+	requestId := uuid.New().String()
+	ts := newTestServer(http.MethodPost, common.ApiDiscoveryRoute, dtoCommon.NewBaseResponse(requestId, "", http.StatusAccepted))
+	defer ts.Close()
+
+	client := NewDeviceServiceDiscoveryClient(ts.URL)
+	res, err := client.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, requestId, res.RequestId)
+}
+
+func TestDiscoveryStatus(t *testing.T) {
+	requestId := uuid.New().String()
+	urlPath := path.Join(common.ApiDiscoveryRoute, common.RequestId, requestId)
+	ts := newTestServer(http.MethodGet, urlPath, responses.NewDiscoveryStatusResponse(requestId, "", http.StatusOK, 100, 2))
+	defer ts.Close()
+
+	client := NewDeviceServiceDiscoveryClient(ts.URL)
+	res, err := client.DiscoveryStatus(context.Background(), requestId)
+	require.NoError(t, err)
+	assert.True(t, res.Completed())
+	assert.Equal(t, 2, res.DiscoveredDeviceCount)
+}
+
+func TestCancelDiscovery(t *testing.T) {
+	requestId := uuid.New().String()
+	urlPath := path.Join(common.ApiDiscoveryRoute, common.RequestId, requestId)
+	ts := newTestServer(http.MethodDelete, urlPath, dtoCommon.NewBaseResponse(requestId, "", http.StatusOK))
+	defer ts.Close()
+
+	client := NewDeviceServiceDiscoveryClient(ts.URL)
+	res, err := client.CancelDiscovery(context.Background(), requestId)
+	require.NoError(t, err)
+	assert.Equal(t, requestId, res.RequestId)
+}
+
+func TestDiscoveryNotSupported(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotImplemented)
+	}))
+	defer ts.Close()
+
+	client := NewDeviceServiceDiscoveryClient(ts.URL)
+	_, err := client.DiscoveryStatus(context.Background(), ExampleUUID)
+	assert.Equal(t, errors.KindNotImplemented, errors.Kind(err))
+	_, err = client.CancelDiscovery(context.Background(), ExampleUUID)
+	assert.Equal(t, errors.KindNotImplemented, errors.Kind(err))
+}
+
+func TestValidateDevice(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			RequestId string
+			Device    struct{ Name string }
+		}
+		if r.Method != http.MethodPost || r.URL.Path != common.ApiDeviceValidationRoute || json.NewDecoder(r.Body).Decode(&request) != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		switch request.Device.Name {
+		case "valid":
+			_ = json.NewEncoder(w).Encode(dtoCommon.NewBaseResponse(request.RequestId, "", http.StatusOK))
+		case "invalid":
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(dtoCommon.NewBaseResponse(request.RequestId, "missing protocol property address", http.StatusBadRequest))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+	client := NewDeviceServiceDiscoveryClient(ts.URL)
+
+	tests := []struct {
+		name          string
+		deviceName    string
+		expectedValid bool
+		expectedError errors.ErrKind
+	}{
+		{"valid", "valid", true, ""},
+		{"invalid", "invalid", false, ""},
+		{"validation unavailable", "unavailable", false, errors.KindServiceUnavailable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			request := requests.NewAddDeviceRequest(dtos.Device{Name: testCase.deviceName})
+			res, err := client.ValidateDevice(context.Background(), request)
+			if testCase.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedError, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedValid, res.Valid)
+			assert.Equal(t, request.RequestId, res.RequestId)
+			if !testCase.expectedValid {
+				assert.Contains(t, res.Message, "missing protocol property address")
+			}
+		})
+	}
+}
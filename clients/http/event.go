@@ -8,40 +8,53 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 )
 
+// eventsField is the JSON field holding the events of responses.MultiEventsResponse
+const eventsField = "events"
+
 type eventClient struct {
 	baseUrl string
+	opts    []utils.ClientOption
 }
 
 // NewEventClient creates an instance of EventClient
-func NewEventClient(baseUrl string) interfaces.EventClient {
+func NewEventClient(baseUrl string, opts ...utils.ClientOption) interfaces.EventClient {
 	return &eventClient{
 		baseUrl: baseUrl,
+		opts:    opts,
 	}
 }
 
 func (ec *eventClient) Add(ctx context.Context, req requests.AddEventRequest) (
 	dtoCommon.BaseWithIdResponse, errors.EdgeX) {
-	path := path.Join(common.ApiEventRoute, req.Event.ProfileName, req.Event.DeviceName, req.Event.SourceName)
 	var br dtoCommon.BaseWithIdResponse
+	requestPath, edgexErr := routes.Build(common.ApiEventProfileNameDeviceNameSourceNameRoute, map[string]string{
+		common.ProfileName: req.Event.ProfileName,
+		common.DeviceName:  req.Event.DeviceName,
+		common.SourceName:  req.Event.SourceName,
+	})
+	if edgexErr != nil {
+		return br, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
 
 	bytes, encoding, err := req.Encode()
 	if err != nil {
 		return br, errors.NewCommonEdgeXWrapper(err)
 	}
 
-	err = utils.PostRequest(ctx, &br, ec.baseUrl, path, bytes, encoding)
+	err = utils.PostRequest(ctx, &br, ec.baseUrl, requestPath, bytes, encoding, ec.opts...)
 	if err != nil {
 		return br, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -53,7 +66,33 @@ func (ec *eventClient) AllEvents(ctx context.Context, offset, limit int) (respon
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
 	res := responses.MultiEventsResponse{}
-	err := utils.GetRequest(ctx, &res, ec.baseUrl, common.ApiAllEventRoute, requestParams)
+	err := utils.GetRequest(ctx, &res, ec.baseUrl, common.ApiAllEventRoute, requestParams, ec.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+func (ec *eventClient) EventById(ctx context.Context, id string) (responses.EventResponse, errors.EdgeX) {
+	res := responses.EventResponse{}
+	requestPath, err := routes.Build(common.ApiEventIdRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, nil, ec.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+func (ec *eventClient) DeleteById(ctx context.Context, id string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res := dtoCommon.BaseResponse{}
+	requestPath, err := routes.Build(common.ApiEventIdRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, ec.baseUrl, requestPath, ec.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -62,7 +101,7 @@ func (ec *eventClient) AllEvents(ctx context.Context, offset, limit int) (respon
 
 func (ec *eventClient) EventCount(ctx context.Context) (dtoCommon.CountResponse, errors.EdgeX) {
 	res := dtoCommon.CountResponse{}
-	err := utils.GetRequest(ctx, &res, ec.baseUrl, common.ApiEventCountRoute, nil)
+	err := utils.GetRequest(ctx, &res, ec.baseUrl, common.ApiEventCountRoute, nil, ec.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -70,9 +109,12 @@ func (ec *eventClient) EventCount(ctx context.Context) (dtoCommon.CountResponse,
 }
 
 func (ec *eventClient) EventCountByDeviceName(ctx context.Context, name string) (dtoCommon.CountResponse, errors.EdgeX) {
-	requestPath := path.Join(common.ApiEventCountRoute, common.Device, common.Name, name)
 	res := dtoCommon.CountResponse{}
-	err := utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, nil)
+	requestPath, err := routes.Build(common.ApiEventCountByDeviceNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, nil, ec.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -81,12 +123,15 @@ func (ec *eventClient) EventCountByDeviceName(ctx context.Context, name string)
 
 func (ec *eventClient) EventsByDeviceName(ctx context.Context, name string, offset, limit int) (
 	responses.MultiEventsResponse, errors.EdgeX) {
-	requestPath := path.Join(common.ApiEventRoute, common.Device, common.Name, name)
+	res := responses.MultiEventsResponse{}
+	requestPath, err := routes.Build(common.ApiEventByDeviceNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	res := responses.MultiEventsResponse{}
-	err := utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, requestParams, ec.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -94,9 +139,12 @@ func (ec *eventClient) EventsByDeviceName(ctx context.Context, name string, offs
 }
 
 func (ec *eventClient) DeleteByDeviceName(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
-	path := path.Join(common.ApiEventRoute, common.Device, common.Name, name)
 	res := dtoCommon.BaseResponse{}
-	err := utils.DeleteRequest(ctx, &res, ec.baseUrl, path)
+	requestPath, err := routes.Build(common.ApiEventByDeviceNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, ec.baseUrl, requestPath, ec.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -105,12 +153,15 @@ func (ec *eventClient) DeleteByDeviceName(ctx context.Context, name string) (dto
 
 func (ec *eventClient) EventsByTimeRange(ctx context.Context, start, end, offset, limit int) (
 	responses.MultiEventsResponse, errors.EdgeX) {
-	requestPath := path.Join(common.ApiEventRoute, common.Start, strconv.Itoa(start), common.End, strconv.Itoa(end))
+	res := responses.MultiEventsResponse{}
+	requestPath, err := routes.Build(common.ApiEventByTimeRangeRoute, map[string]string{common.Start: strconv.Itoa(start), common.End: strconv.Itoa(end)})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	res := responses.MultiEventsResponse{}
-	err := utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, requestParams, ec.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -118,9 +169,44 @@ func (ec *eventClient) EventsByTimeRange(ctx context.Context, start, end, offset
 }
 
 func (ec *eventClient) DeleteByAge(ctx context.Context, age int) (dtoCommon.BaseResponse, errors.EdgeX) {
-	path := path.Join(common.ApiEventRoute, common.Age, strconv.Itoa(age))
 	res := dtoCommon.BaseResponse{}
-	err := utils.DeleteRequest(ctx, &res, ec.baseUrl, path)
+	requestPath, err := routes.Build(common.ApiEventByAgeRoute, map[string]string{common.Age: strconv.Itoa(age)})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, ec.baseUrl, requestPath, ec.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// AllEventsStream returns all events sorted in descending order of created time, each event is passed to the handler as soon as it is decoded.
+func (ec *eventClient) AllEventsStream(ctx context.Context, offset, limit int, handler func(dtos.Event) errors.EdgeX) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	return ec.eventsStream(ctx, common.ApiAllEventRoute, requestParams, handler)
+}
+
+// EventsByDeviceNameStream returns the events of the specified device, each event is passed to the handler as soon as it is decoded.
+func (ec *eventClient) EventsByDeviceNameStream(ctx context.Context, name string, offset, limit int, handler func(dtos.Event) errors.EdgeX) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestPath, err := routes.Build(common.ApiEventByDeviceNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return dtoCommon.BaseWithTotalCountResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	return ec.eventsStream(ctx, requestPath, requestParams, handler)
+}
+
+func (ec *eventClient) eventsStream(ctx context.Context, requestPath string, requestParams url.Values, handler func(dtos.Event) errors.EdgeX) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	res := dtoCommon.BaseWithTotalCountResponse{}
+	err := utils.GetRequestStream(ctx, &res, ec.baseUrl, requestPath, requestParams, eventsField,
+		func() interface{} { return &dtos.Event{} },
+		func(element interface{}) errors.EdgeX { return handler(*element.(*dtos.Event)) },
+		ec.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
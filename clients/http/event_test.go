@@ -6,25 +6,35 @@
 package http
 
 import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"strconv"
 	"testing"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/fxamacker/cbor/v2"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestAddEvent(t *testing.T) {
-	event := dtos.Event{ProfileName: "profileName", DeviceName: "deviceName"}
-	apiRoute := path.Join(common.ApiEventRoute, event.ProfileName, event.DeviceName)
+	event := dtos.Event{ProfileName: "profileName", DeviceName: "deviceName", SourceName: "sourceName"}
+	apiRoute := path.Join(common.ApiEventRoute, event.ProfileName, event.DeviceName, event.SourceName)
 	ts := newTestServer(http.MethodPost, apiRoute, dtoCommon.BaseWithIdResponse{})
 	defer ts.Close()
 
@@ -32,6 +42,67 @@ func TestAddEvent(t *testing.T) {
 	res, err := client.Add(context.Background(), requests.AddEventRequest{Event: event})
 	require.NoError(t, err)
 	assert.IsType(t, dtoCommon.BaseWithIdResponse{}, res)
+
+	event.SourceName = ""
+	_, err = client.Add(context.Background(), requests.AddEventRequest{Event: event})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestAddEventWithCompression(t *testing.T) {
+	event := dtos.NewEvent("profileName", "deviceName", "sourceName")
+	event.AddBinaryReading("resource", bytes.Repeat([]byte("edgex"), 1024), "application/octet-stream")
+	apiRoute := path.Join(common.ApiEventRoute, event.ProfileName, event.DeviceName, event.SourceName)
+	expected := dtoCommon.NewBaseWithIdResponse("", "", http.StatusCreated, ExampleUUID)
+
+	tests := []struct {
+		name             string
+		encoding         string
+		threshold        int
+		expectedEncoding string
+	}{
+		{"gzip", common.ContentEncodingGzip, 1024, common.ContentEncodingGzip},
+		{"deflate", common.ContentEncodingDeflate, 1024, common.ContentEncodingDeflate},
+		{"below threshold", common.ContentEncodingGzip, 1024 * 1024, ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.EscapedPath() != apiRoute || r.Header.Get(common.ContentEncoding) != testCase.expectedEncoding {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				var body io.Reader = r.Body
+				switch testCase.expectedEncoding {
+				case common.ContentEncodingGzip:
+					body, _ = gzip.NewReader(r.Body)
+				case common.ContentEncodingDeflate:
+					body, _ = zlib.NewReader(r.Body)
+				}
+				var req requests.AddEventRequest
+				data, _ := io.ReadAll(body)
+				if err := req.UnmarshalCBOR(data); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				// Always reply with a gzip encoded body to verify the response decompression
+				b, _ := json.Marshal(expected)
+				w.Header().Set(common.ContentType, common.ContentTypeJSON)
+				w.Header().Set(common.ContentEncoding, common.ContentEncodingGzip)
+				w.WriteHeader(http.StatusCreated)
+				gw := gzip.NewWriter(w)
+				_, _ = gw.Write(b)
+				_ = gw.Close()
+			}))
+			defer ts.Close()
+
+			client := NewEventClient(ts.URL, utils.WithCompression(testCase.encoding, testCase.threshold))
+			res, err := client.Add(context.Background(), requests.NewAddEventRequest(event))
+			require.NoError(t, err)
+			assert.Equal(t, expected, res)
+		})
+	}
 }
 
 func TestQueryAllEvents(t *testing.T) {
@@ -44,6 +115,28 @@ func TestQueryAllEvents(t *testing.T) {
 	assert.IsType(t, responses.MultiEventsResponse{}, res)
 }
 
+func TestQueryEventById(t *testing.T) {
+	path := path.Join(common.ApiEventRoute, common.Id, ExampleUUID)
+	ts := newTestServer(http.MethodGet, path, responses.EventResponse{})
+	defer ts.Close()
+
+	client := NewEventClient(ts.URL)
+	res, err := client.EventById(context.Background(), ExampleUUID)
+	require.NoError(t, err)
+	assert.IsType(t, responses.EventResponse{}, res)
+}
+
+func TestDeleteEventById(t *testing.T) {
+	path := path.Join(common.ApiEventRoute, common.Id, ExampleUUID)
+	ts := newTestServer(http.MethodDelete, path, dtoCommon.BaseResponse{})
+	defer ts.Close()
+
+	client := NewEventClient(ts.URL)
+	res, err := client.DeleteById(context.Background(), ExampleUUID)
+	require.NoError(t, err)
+	assert.IsType(t, dtoCommon.BaseResponse{}, res)
+}
+
 func TestQueryEventCount(t *testing.T) {
 	ts := newTestServer(http.MethodGet, common.ApiEventCountRoute, dtoCommon.CountResponse{})
 	defer ts.Close()
@@ -114,3 +207,54 @@ func TestDeleteEventsByAge(t *testing.T) {
 	require.NoError(t, err)
 	assert.IsType(t, dtoCommon.BaseResponse{}, res)
 }
+
+func TestQueryAllEventsStream(t *testing.T) {
+	events := []dtos.Event{
+		dtos.NewEvent("profile", "device01", "source"),
+		dtos.NewEvent("profile", "device02", "source"),
+	}
+	expected := responses.NewMultiEventsResponse("", "", http.StatusOK, 2, events)
+	ts := newTestServer(http.MethodGet, common.ApiAllEventRoute, expected)
+	defer ts.Close()
+
+	client := NewEventClient(ts.URL)
+	var actual []dtos.Event
+	res, err := client.AllEventsStream(context.Background(), 0, -1, func(e dtos.Event) errors.EdgeX {
+		actual = append(actual, e)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, expected.BaseWithTotalCountResponse, res)
+	assert.Equal(t, events, actual)
+}
+
+func TestQueryEventsByDeviceNameStreamWithCBOR(t *testing.T) {
+	deviceName := "device"
+	events := []dtos.Event{
+		dtos.NewEvent("profile", deviceName, "source01"),
+		dtos.NewEvent("profile", deviceName, "source02"),
+	}
+	expected := responses.NewMultiEventsResponse("", "", http.StatusOK, 2, events)
+	urlPath := path.Join(common.ApiEventRoute, common.Device, common.Name, deviceName)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != urlPath {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		b, _ := cbor.Marshal(expected)
+		w.Header().Set(common.ContentType, common.ContentTypeCBOR)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(b)
+	}))
+	defer ts.Close()
+
+	client := NewEventClient(ts.URL)
+	var actual []dtos.Event
+	res, err := client.EventsByDeviceNameStream(context.Background(), deviceName, 0, -1, func(e dtos.Event) errors.EdgeX {
+		actual = append(actual, e)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, expected.BaseWithTotalCountResponse, res)
+	assert.Equal(t, events, actual)
+}
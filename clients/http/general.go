@@ -17,16 +17,18 @@ import (
 
 type generalClient struct {
 	baseUrl string
+	opts    []utils.ClientOption
 }
 
-func NewGeneralClient(baseUrl string) interfaces.GeneralClient {
+func NewGeneralClient(baseUrl string, opts ...utils.ClientOption) interfaces.GeneralClient {
 	return &generalClient{
 		baseUrl: baseUrl,
+		opts:    opts,
 	}
 }
 
 func (g *generalClient) FetchConfiguration(ctx context.Context) (res dtoCommon.ConfigResponse, err errors.EdgeX) {
-	err = utils.GetRequest(ctx, &res, g.baseUrl, common.ApiConfigRoute, nil)
+	err = utils.GetRequest(ctx, &res, g.baseUrl, common.ApiConfigRoute, nil, g.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -8,9 +8,9 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -22,19 +22,21 @@ import (
 
 type IntervalClient struct {
 	baseUrl string
+	opts    []utils.ClientOption
 }
 
 // NewIntervalClient creates an instance of IntervalClient
-func NewIntervalClient(baseUrl string) interfaces.IntervalClient {
+func NewIntervalClient(baseUrl string, opts ...utils.ClientOption) interfaces.IntervalClient {
 	return &IntervalClient{
 		baseUrl: baseUrl,
+		opts:    opts,
 	}
 }
 
 // Add adds new intervals
 func (client IntervalClient) Add(ctx context.Context, reqs []requests.AddIntervalRequest) (
 	res []dtoCommon.BaseWithIdResponse, err errors.EdgeX) {
-	err = utils.PostRequestWithRawData(ctx, &res, client.baseUrl, common.ApiIntervalRoute, nil, reqs)
+	err = utils.PostRequestWithRawData(ctx, &res, client.baseUrl, common.ApiIntervalRoute, nil, reqs, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -44,7 +46,7 @@ func (client IntervalClient) Add(ctx context.Context, reqs []requests.AddInterva
 // Update updates intervals
 func (client IntervalClient) Update(ctx context.Context, reqs []requests.UpdateIntervalRequest) (
 	res []dtoCommon.BaseResponse, err errors.EdgeX) {
-	err = utils.PatchRequest(ctx, &res, client.baseUrl, common.ApiIntervalRoute, nil, reqs)
+	err = utils.PatchRequest(ctx, &res, client.baseUrl, common.ApiIntervalRoute, nil, reqs, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -57,7 +59,7 @@ func (client IntervalClient) AllIntervals(ctx context.Context, offset int, limit
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllIntervalRoute, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllIntervalRoute, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -67,8 +69,11 @@ func (client IntervalClient) AllIntervals(ctx context.Context, offset int, limit
 // IntervalByName query the interval by name
 func (client IntervalClient) IntervalByName(ctx context.Context, name string) (
 	res responses.IntervalResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiIntervalRoute, common.Name, name)
-	err = utils.GetRequest(ctx, &res, client.baseUrl, path, nil)
+	requestPath, err := routes.Build(common.ApiIntervalByNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -78,8 +83,11 @@ func (client IntervalClient) IntervalByName(ctx context.Context, name string) (
 // DeleteIntervalByName delete the interval by name
 func (client IntervalClient) DeleteIntervalByName(ctx context.Context, name string) (
 	res dtoCommon.BaseResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiIntervalRoute, common.Name, name)
-	err = utils.DeleteRequest(ctx, &res, client.baseUrl, path)
+	requestPath, err := routes.Build(common.ApiIntervalByNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, client.baseUrl, requestPath, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
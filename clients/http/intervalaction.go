@@ -8,9 +8,9 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -22,19 +22,21 @@ import (
 
 type IntervalActionClient struct {
 	baseUrl string
+	opts    []utils.ClientOption
 }
 
 // NewIntervalActionClient creates an instance of IntervalActionClient
-func NewIntervalActionClient(baseUrl string) interfaces.IntervalActionClient {
+func NewIntervalActionClient(baseUrl string, opts ...utils.ClientOption) interfaces.IntervalActionClient {
 	return &IntervalActionClient{
 		baseUrl: baseUrl,
+		opts:    opts,
 	}
 }
 
 // Add adds new intervalActions
 func (client IntervalActionClient) Add(ctx context.Context, reqs []requests.AddIntervalActionRequest) (
 	res []dtoCommon.BaseWithIdResponse, err errors.EdgeX) {
-	err = utils.PostRequestWithRawData(ctx, &res, client.baseUrl, common.ApiIntervalActionRoute, nil, reqs)
+	err = utils.PostRequestWithRawData(ctx, &res, client.baseUrl, common.ApiIntervalActionRoute, nil, reqs, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -44,7 +46,7 @@ func (client IntervalActionClient) Add(ctx context.Context, reqs []requests.AddI
 // Update updates intervalActions
 func (client IntervalActionClient) Update(ctx context.Context, reqs []requests.UpdateIntervalActionRequest) (
 	res []dtoCommon.BaseResponse, err errors.EdgeX) {
-	err = utils.PatchRequest(ctx, &res, client.baseUrl, common.ApiIntervalActionRoute, nil, reqs)
+	err = utils.PatchRequest(ctx, &res, client.baseUrl, common.ApiIntervalActionRoute, nil, reqs, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -57,7 +59,7 @@ func (client IntervalActionClient) AllIntervalActions(ctx context.Context, offse
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllIntervalActionRoute, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllIntervalActionRoute, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -67,8 +69,28 @@ func (client IntervalActionClient) AllIntervalActions(ctx context.Context, offse
 // IntervalActionByName query the intervalAction by name
 func (client IntervalActionClient) IntervalActionByName(ctx context.Context, name string) (
 	res responses.IntervalActionResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiIntervalActionRoute, common.Name, name)
-	err = utils.GetRequest(ctx, &res, client.baseUrl, path, nil)
+	requestPath, err := routes.Build(common.ApiIntervalActionByNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil, client.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// IntervalActionsByTarget query the intervalActions by target with offset and limit
+func (client IntervalActionClient) IntervalActionsByTarget(ctx context.Context, target string, offset int, limit int) (
+	res responses.MultiIntervalActionsResponse, err errors.EdgeX) {
+	requestPath, err := routes.Build(common.ApiIntervalActionByTargetRoute, map[string]string{common.Target: target})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -78,8 +100,11 @@ func (client IntervalActionClient) IntervalActionByName(ctx context.Context, nam
 // DeleteIntervalActionByName delete the intervalAction by name
 func (client IntervalActionClient) DeleteIntervalActionByName(ctx context.Context, name string) (
 	res dtoCommon.BaseResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiIntervalActionRoute, common.Name, name)
-	err = utils.DeleteRequest(ctx, &res, client.baseUrl, path)
+	requestPath, err := routes.Build(common.ApiIntervalActionByNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, client.baseUrl, requestPath, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -70,6 +70,19 @@ func TestQueryIntervalActionByName(t *testing.T) {
 	assert.IsType(t, responses.IntervalActionResponse{}, res)
 }
 
+func TestQueryIntervalActionsByTarget(t *testing.T) {
+	target := "core-command"
+	path := path.Join(common.ApiIntervalActionRoute, common.Target, target)
+	ts := newTestServer(http.MethodGet, path, responses.MultiIntervalActionsResponse{})
+	defer ts.Close()
+	client := NewIntervalActionClient(ts.URL)
+
+	res, err := client.IntervalActionsByTarget(context.Background(), target, 0, 10)
+
+	require.NoError(t, err)
+	assert.IsType(t, responses.MultiIntervalActionsResponse{}, res)
+}
+
 func TestDeleteIntervalActionByName(t *testing.T) {
 	path := path.Join(common.ApiIntervalActionRoute, common.Name, TestIntervalActionName)
 	ts := newTestServer(http.MethodDelete, path, dtoCommon.BaseResponse{})
@@ -8,9 +8,9 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -22,18 +22,20 @@ import (
 
 type NotificationClient struct {
 	baseUrl string
+	opts    []utils.ClientOption
 }
 
 // NewNotificationClient creates an instance of NotificationClient
-func NewNotificationClient(baseUrl string) interfaces.NotificationClient {
+func NewNotificationClient(baseUrl string, opts ...utils.ClientOption) interfaces.NotificationClient {
 	return &NotificationClient{
 		baseUrl: baseUrl,
+		opts:    opts,
 	}
 }
 
 // SendNotification sends new notifications.
 func (client *NotificationClient) SendNotification(ctx context.Context, reqs []requests.AddNotificationRequest) (res []dtoCommon.BaseWithIdResponse, err errors.EdgeX) {
-	err = utils.PostRequestWithRawData(ctx, &res, client.baseUrl, common.ApiNotificationRoute, nil, reqs)
+	err = utils.PostRequestWithRawData(ctx, &res, client.baseUrl, common.ApiNotificationRoute, nil, reqs, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -42,8 +44,11 @@ func (client *NotificationClient) SendNotification(ctx context.Context, reqs []r
 
 // NotificationById query notification by id.
 func (client *NotificationClient) NotificationById(ctx context.Context, id string) (res responses.NotificationResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiNotificationRoute, common.Id, id)
-	err = utils.GetRequest(ctx, &res, client.baseUrl, path, nil)
+	requestPath, err := routes.Build(common.ApiNotificationByIdRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -52,8 +57,11 @@ func (client *NotificationClient) NotificationById(ctx context.Context, id strin
 
 // DeleteNotificationById deletes a notification by id.
 func (client *NotificationClient) DeleteNotificationById(ctx context.Context, id string) (res dtoCommon.BaseResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiNotificationRoute, common.Id, id)
-	err = utils.DeleteRequest(ctx, &res, client.baseUrl, path)
+	requestPath, err := routes.Build(common.ApiNotificationByIdRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, client.baseUrl, requestPath, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -62,11 +70,14 @@ func (client *NotificationClient) DeleteNotificationById(ctx context.Context, id
 
 // NotificationsByCategory queries notifications with category, offset and limit
 func (client *NotificationClient) NotificationsByCategory(ctx context.Context, category string, offset int, limit int) (res responses.MultiNotificationsResponse, err errors.EdgeX) {
-	requestPath := path.Join(common.ApiNotificationRoute, common.Category, category)
+	requestPath, err := routes.Build(common.ApiNotificationByCategoryRoute, map[string]string{common.Category: category})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -75,11 +86,14 @@ func (client *NotificationClient) NotificationsByCategory(ctx context.Context, c
 
 // NotificationsByLabel queries notifications with label, offset and limit
 func (client *NotificationClient) NotificationsByLabel(ctx context.Context, label string, offset int, limit int) (res responses.MultiNotificationsResponse, err errors.EdgeX) {
-	requestPath := path.Join(common.ApiNotificationRoute, common.Label, label)
+	requestPath, err := routes.Build(common.ApiNotificationByLabelRoute, map[string]string{common.Label: label})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -88,11 +102,14 @@ func (client *NotificationClient) NotificationsByLabel(ctx context.Context, labe
 
 // NotificationsByStatus queries notifications with status, offset and limit
 func (client *NotificationClient) NotificationsByStatus(ctx context.Context, status string, offset int, limit int) (res responses.MultiNotificationsResponse, err errors.EdgeX) {
-	requestPath := path.Join(common.ApiNotificationRoute, common.Status, status)
+	requestPath, err := routes.Build(common.ApiNotificationByStatusRoute, map[string]string{common.Status: status})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -101,11 +118,14 @@ func (client *NotificationClient) NotificationsByStatus(ctx context.Context, sta
 
 // NotificationsByTimeRange query notifications with time range, offset and limit
 func (client *NotificationClient) NotificationsByTimeRange(ctx context.Context, start int, end int, offset int, limit int) (res responses.MultiNotificationsResponse, err errors.EdgeX) {
-	requestPath := path.Join(common.ApiNotificationRoute, common.Start, strconv.Itoa(start), common.End, strconv.Itoa(end))
+	requestPath, err := routes.Build(common.ApiNotificationByTimeRangeRoute, map[string]string{common.Start: strconv.Itoa(start), common.End: strconv.Itoa(end)})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -114,11 +134,14 @@ func (client *NotificationClient) NotificationsByTimeRange(ctx context.Context,
 
 // NotificationsBySubscriptionName query notifications with subscriptionName, offset and limit
 func (client *NotificationClient) NotificationsBySubscriptionName(ctx context.Context, subscriptionName string, offset int, limit int) (res responses.MultiNotificationsResponse, err errors.EdgeX) {
-	requestPath := path.Join(common.ApiNotificationRoute, common.Subscription, common.Name, subscriptionName)
+	requestPath, err := routes.Build(common.ApiNotificationBySubscriptionNameRoute, map[string]string{common.Name: subscriptionName})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -128,8 +151,11 @@ func (client *NotificationClient) NotificationsBySubscriptionName(ctx context.Co
 // CleanupNotificationsByAge removes notifications that are older than age. And the corresponding transmissions will also be deleted.
 // Age is supposed in milliseconds since modified timestamp
 func (client *NotificationClient) CleanupNotificationsByAge(ctx context.Context, age int) (res dtoCommon.BaseResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiNotificationCleanupRoute, common.Age, strconv.Itoa(age))
-	err = utils.DeleteRequest(ctx, &res, client.baseUrl, path)
+	requestPath, err := routes.Build(common.ApiNotificationCleanupByAgeRoute, map[string]string{common.Age: strconv.Itoa(age)})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, client.baseUrl, requestPath, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -138,7 +164,7 @@ func (client *NotificationClient) CleanupNotificationsByAge(ctx context.Context,
 
 // CleanupNotifications removes notifications and the corresponding transmissions.
 func (client *NotificationClient) CleanupNotifications(ctx context.Context) (res dtoCommon.BaseResponse, err errors.EdgeX) {
-	err = utils.DeleteRequest(ctx, &res, client.baseUrl, common.ApiNotificationCleanupRoute)
+	err = utils.DeleteRequest(ctx, &res, client.baseUrl, common.ApiNotificationCleanupRoute, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -149,8 +175,11 @@ func (client *NotificationClient) CleanupNotifications(ctx context.Context) (res
 // Age is supposed in milliseconds since modified timestamp
 // Please notice that this API is only for processed notifications (status = PROCESSED). If the deletion purpose includes each kind of notifications, please refer to cleanup API.
 func (client *NotificationClient) DeleteProcessedNotificationsByAge(ctx context.Context, age int) (res dtoCommon.BaseResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiNotificationRoute, common.Age, strconv.Itoa(age))
-	err = utils.DeleteRequest(ctx, &res, client.baseUrl, path)
+	requestPath, err := routes.Build(common.ApiNotificationByAgeRoute, map[string]string{common.Age: strconv.Itoa(age)})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, client.baseUrl, requestPath, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -60,6 +60,17 @@ func TestProvisionWatcherClient_ProvisionWatcherByName(t *testing.T) {
 	require.IsType(t, responses.ProvisionWatcherResponse{}, res)
 }
 
+func TestProvisionWatcherClient_ProvisionWatcherById(t *testing.T) {
+	urlPath := path.Join(common.ApiProvisionWatcherRoute, common.Id, ExampleUUID)
+	ts := newTestServer(http.MethodGet, urlPath, responses.ProvisionWatcherResponse{})
+	defer ts.Close()
+
+	client := NewProvisionWatcherClient(ts.URL)
+	res, err := client.ProvisionWatcherById(context.Background(), ExampleUUID)
+	require.NoError(t, err)
+	require.IsType(t, responses.ProvisionWatcherResponse{}, res)
+}
+
 func TestProvisionWatcherClient_DeleteProvisionWatcherByName(t *testing.T) {
 	pwName := "watcher"
 	urlPath := path.Join(common.ApiProvisionWatcherRoute, common.Name, pwName)
@@ -72,6 +83,17 @@ func TestProvisionWatcherClient_DeleteProvisionWatcherByName(t *testing.T) {
 	require.IsType(t, dtoCommon.BaseResponse{}, res)
 }
 
+func TestProvisionWatcherClient_DeleteProvisionWatcherById(t *testing.T) {
+	urlPath := path.Join(common.ApiProvisionWatcherRoute, common.Id, ExampleUUID)
+	ts := newTestServer(http.MethodDelete, urlPath, dtoCommon.BaseResponse{})
+	defer ts.Close()
+
+	client := NewProvisionWatcherClient(ts.URL)
+	res, err := client.DeleteProvisionWatcherById(context.Background(), ExampleUUID)
+	require.NoError(t, err)
+	require.IsType(t, dtoCommon.BaseResponse{}, res)
+}
+
 func TestProvisionWatcherClient_ProvisionWatchersByProfileName(t *testing.T) {
 	profileName := "profile"
 	urlPath := path.Join(common.ApiProvisionWatcherRoute, common.Profile, common.Name, profileName)
@@ -8,25 +8,31 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 )
 
+// readingsField is the JSON field holding the readings of responses.MultiReadingsResponse
+const readingsField = "readings"
+
 type readingClient struct {
 	baseUrl string
+	opts    []utils.ClientOption
 }
 
 // NewReadingClient creates an instance of ReadingClient
-func NewReadingClient(baseUrl string) interfaces.ReadingClient {
+func NewReadingClient(baseUrl string, opts ...utils.ClientOption) interfaces.ReadingClient {
 	return &readingClient{
 		baseUrl: baseUrl,
+		opts:    opts,
 	}
 }
 
@@ -35,7 +41,7 @@ func (rc readingClient) AllReadings(ctx context.Context, offset, limit int) (res
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
 	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, common.ApiAllReadingRoute, requestParams)
+	err := utils.GetRequest(ctx, &res, rc.baseUrl, common.ApiAllReadingRoute, requestParams, rc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -44,7 +50,7 @@ func (rc readingClient) AllReadings(ctx context.Context, offset, limit int) (res
 
 func (rc readingClient) ReadingCount(ctx context.Context) (dtoCommon.CountResponse, errors.EdgeX) {
 	res := dtoCommon.CountResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, common.ApiReadingCountRoute, nil)
+	err := utils.GetRequest(ctx, &res, rc.baseUrl, common.ApiReadingCountRoute, nil, rc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -52,9 +58,12 @@ func (rc readingClient) ReadingCount(ctx context.Context) (dtoCommon.CountRespon
 }
 
 func (rc readingClient) ReadingCountByDeviceName(ctx context.Context, name string) (dtoCommon.CountResponse, errors.EdgeX) {
-	requestPath := path.Join(common.ApiReadingCountRoute, common.Device, common.Name, name)
 	res := dtoCommon.CountResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, nil)
+	requestPath, err := routes.Build(common.ApiReadingCountByDeviceNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, nil, rc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -62,12 +71,36 @@ func (rc readingClient) ReadingCountByDeviceName(ctx context.Context, name strin
 }
 
 func (rc readingClient) ReadingsByDeviceName(ctx context.Context, name string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
-	requestPath := path.Join(common.ApiReadingRoute, common.Device, common.Name, name)
+	res := responses.MultiReadingsResponse{}
+	requestPath, err := routes.Build(common.ApiReadingByDeviceNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	err = utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams, rc.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// ReadingsByDeviceNameAndTimeRange returns readings by device name and specified time range. Readings are sorted in descending order of origin time.
+func (rc readingClient) ReadingsByDeviceNameAndTimeRange(ctx context.Context, name string, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
 	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	requestPath, err := routes.Build(common.ApiReadingByDeviceNameAndTimeRangeRoute, map[string]string{
+		common.Name:  name,
+		common.Start: strconv.Itoa(start),
+		common.End:   strconv.Itoa(end),
+	})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	err = utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams, rc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -75,12 +108,15 @@ func (rc readingClient) ReadingsByDeviceName(ctx context.Context, name string, o
 }
 
 func (rc readingClient) ReadingsByResourceName(ctx context.Context, name string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
-	requestPath := path.Join(common.ApiReadingRoute, common.ResourceName, name)
+	res := responses.MultiReadingsResponse{}
+	requestPath, err := routes.Build(common.ApiReadingByResourceNameRoute, map[string]string{common.ResourceName: name})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams, rc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -88,12 +124,15 @@ func (rc readingClient) ReadingsByResourceName(ctx context.Context, name string,
 }
 
 func (rc readingClient) ReadingsByTimeRange(ctx context.Context, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
-	requestPath := path.Join(common.ApiReadingRoute, common.Start, strconv.Itoa(start), common.End, strconv.Itoa(end))
+	res := responses.MultiReadingsResponse{}
+	requestPath, err := routes.Build(common.ApiReadingByTimeRangeRoute, map[string]string{common.Start: strconv.Itoa(start), common.End: strconv.Itoa(end)})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams, rc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -102,12 +141,19 @@ func (rc readingClient) ReadingsByTimeRange(ctx context.Context, start, end, off
 
 // ReadingsByResourceNameAndTimeRange returns readings by resource name and specified time range. Readings are sorted in descending order of origin time.
 func (rc readingClient) ReadingsByResourceNameAndTimeRange(ctx context.Context, name string, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
-	requestPath := path.Join(common.ApiReadingRoute, common.ResourceName, name, common.Start, strconv.Itoa(start), common.End, strconv.Itoa(end))
+	res := responses.MultiReadingsResponse{}
+	requestPath, err := routes.Build(common.ApiReadingByResourceNameAndTimeRangeRoute, map[string]string{
+		common.ResourceName: name,
+		common.Start:        strconv.Itoa(start),
+		common.End:          strconv.Itoa(end),
+	})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams, rc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -115,12 +161,15 @@ func (rc readingClient) ReadingsByResourceNameAndTimeRange(ctx context.Context,
 }
 
 func (rc readingClient) ReadingsByDeviceNameAndResourceName(ctx context.Context, deviceName, resourceName string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
-	requestPath := path.Join(common.ApiReadingRoute, common.Device, common.Name, deviceName, common.ResourceName, resourceName)
+	res := responses.MultiReadingsResponse{}
+	requestPath, err := routes.Build(common.ApiReadingByDeviceNameAndResourceNameRoute, map[string]string{common.Name: deviceName, common.ResourceName: resourceName})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams, rc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -129,12 +178,20 @@ func (rc readingClient) ReadingsByDeviceNameAndResourceName(ctx context.Context,
 }
 
 func (rc readingClient) ReadingsByDeviceNameAndResourceNameAndTimeRange(ctx context.Context, deviceName, resourceName string, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
-	requestPath := path.Join(common.ApiReadingRoute, common.Device, common.Name, deviceName, common.ResourceName, resourceName, common.Start, strconv.Itoa(start), common.End, strconv.Itoa(end))
+	res := responses.MultiReadingsResponse{}
+	requestPath, err := routes.Build(common.ApiReadingByDeviceNameAndResourceNameAndTimeRangeRoute, map[string]string{
+		common.Name:         deviceName,
+		common.ResourceName: resourceName,
+		common.Start:        strconv.Itoa(start),
+		common.End:          strconv.Itoa(end),
+	})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams, rc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -142,7 +199,15 @@ func (rc readingClient) ReadingsByDeviceNameAndResourceNameAndTimeRange(ctx cont
 }
 
 func (rc readingClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx context.Context, deviceName string, resourceNames []string, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
-	requestPath := path.Join(common.ApiReadingRoute, common.Device, common.Name, deviceName, common.Start, strconv.Itoa(start), common.End, strconv.Itoa(end))
+	res := responses.MultiReadingsResponse{}
+	requestPath, err := routes.Build(common.ApiReadingByDeviceNameAndTimeRangeRoute, map[string]string{
+		common.Name:  deviceName,
+		common.Start: strconv.Itoa(start),
+		common.End:   strconv.Itoa(end),
+	})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
@@ -151,8 +216,39 @@ func (rc readingClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx con
 		queryPayload = make(map[string]interface{}, 1)
 		queryPayload[common.ResourceNames] = resourceNames
 	}
-	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequestWithBodyRawData(ctx, &res, rc.baseUrl, requestPath, requestParams, queryPayload)
+	err = utils.GetRequestWithBodyRawData(ctx, &res, rc.baseUrl, requestPath, requestParams, queryPayload, rc.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// AllReadingsStream returns all readings sorted in descending order of created time, each reading is passed to the handler as soon as it is decoded.
+func (rc readingClient) AllReadingsStream(ctx context.Context, offset, limit int, handler func(dtos.BaseReading) errors.EdgeX) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	return rc.readingsStream(ctx, common.ApiAllReadingRoute, requestParams, handler)
+}
+
+// ReadingsByDeviceNameStream returns the readings of the specified device, each reading is passed to the handler as soon as it is decoded.
+func (rc readingClient) ReadingsByDeviceNameStream(ctx context.Context, name string, offset, limit int, handler func(dtos.BaseReading) errors.EdgeX) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestPath, err := routes.Build(common.ApiReadingByDeviceNameRoute, map[string]string{common.Name: name})
+	if err != nil {
+		return dtoCommon.BaseWithTotalCountResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	return rc.readingsStream(ctx, requestPath, requestParams, handler)
+}
+
+func (rc readingClient) readingsStream(ctx context.Context, requestPath string, requestParams url.Values, handler func(dtos.BaseReading) errors.EdgeX) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	res := dtoCommon.BaseWithTotalCountResponse{}
+	err := utils.GetRequestStream(ctx, &res, rc.baseUrl, requestPath, requestParams, readingsField,
+		func() interface{} { return &dtos.BaseReading{} },
+		func(element interface{}) errors.EdgeX { return handler(*element.(*dtos.BaseReading)) },
+		rc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
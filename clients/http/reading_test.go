@@ -15,9 +15,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 )
 
 func TestQueryAllReadings(t *testing.T) {
@@ -64,6 +67,20 @@ func TestQueryReadingsByDeviceName(t *testing.T) {
 	assert.IsType(t, responses.MultiReadingsResponse{}, res)
 }
 
+func TestQueryReadingsByDeviceNameAndTimeRange(t *testing.T) {
+	deviceName := "device"
+	start := 1
+	end := 10
+	urlPath := path.Join(common.ApiReadingRoute, common.Device, common.Name, deviceName, common.Start, strconv.Itoa(start), common.End, strconv.Itoa(end))
+	ts := newTestServer(http.MethodGet, urlPath, responses.MultiReadingsResponse{})
+	defer ts.Close()
+
+	client := NewReadingClient(ts.URL)
+	res, err := client.ReadingsByDeviceNameAndTimeRange(context.Background(), deviceName, start, end, 1, 10)
+	require.NoError(t, err)
+	assert.IsType(t, responses.MultiReadingsResponse{}, res)
+}
+
 func TestQueryReadingsByResourceName(t *testing.T) {
 	resourceName := "resource"
 	urlPath := path.Join(common.ApiReadingRoute, common.ResourceName, resourceName)
@@ -145,3 +162,110 @@ func TestQueryReadingsByDeviceNameAndResourceNamesAndTimeRange(t *testing.T) {
 	require.NoError(t, err)
 	assert.IsType(t, responses.MultiReadingsResponse{}, res)
 }
+
+func TestQueryAllReadingsStream(t *testing.T) {
+	readings := []dtos.BaseReading{
+		newTestReading(t, "device", "resource01", int32(1)),
+		newTestReading(t, "device", "resource02", int32(2)),
+	}
+	expected := responses.NewMultiReadingsResponse("", "", http.StatusOK, 2, readings)
+	ts := newTestServer(http.MethodGet, common.ApiAllReadingRoute, expected)
+	defer ts.Close()
+
+	client := NewReadingClient(ts.URL)
+	var actual []dtos.BaseReading
+	res, err := client.AllReadingsStream(context.Background(), 0, -1, func(r dtos.BaseReading) errors.EdgeX {
+		actual = append(actual, r)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, expected.BaseWithTotalCountResponse, res)
+	require.Len(t, actual, len(readings))
+	assert.Equal(t, readings[0].ResourceName, actual[0].ResourceName)
+	assert.Equal(t, readings[1].Value, actual[1].Value)
+}
+
+func TestQueryReadingsByDeviceNameStream(t *testing.T) {
+	deviceName := "device"
+	readings := []dtos.BaseReading{
+		newTestReading(t, deviceName, "resource01", int32(1)),
+		newTestReading(t, deviceName, "resource02", int32(2)),
+	}
+	urlPath := path.Join(common.ApiReadingRoute, common.Device, common.Name, deviceName)
+	ts := newTestServer(http.MethodGet, urlPath, responses.NewMultiReadingsResponse("", "", http.StatusOK, 2, readings))
+	defer ts.Close()
+
+	tests := []struct {
+		name          string
+		handlerErr    errors.EdgeX
+		expectedCalls int
+	}{
+		{"valid", nil, 2},
+		{"handler error stops decoding", errors.NewCommonEdgeX(errors.KindServerError, "failed", nil), 1},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			client := NewReadingClient(ts.URL)
+			calls := 0
+			_, err := client.ReadingsByDeviceNameStream(context.Background(), deviceName, 0, -1, func(r dtos.BaseReading) errors.EdgeX {
+				calls++
+				return testCase.handlerErr
+			})
+			if testCase.handlerErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindServerError, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.expectedCalls, calls)
+		})
+	}
+}
+
+func TestQueryAllReadingsWithMaxResponseSize(t *testing.T) {
+	readings := make([]dtos.BaseReading, 100)
+	for i := range readings {
+		readings[i] = newTestReading(t, "device", "resource", int32(i))
+	}
+	ts := newTestServer(http.MethodGet, common.ApiAllReadingRoute, responses.NewMultiReadingsResponse("", "", http.StatusOK, 100, readings))
+	defer ts.Close()
+
+	tests := []struct {
+		name            string
+		maxResponseSize int64
+		expectedErrKind errors.ErrKind
+	}{
+		{"no limit", 0, ""},
+		{"within limit", 1024 * 1024, ""},
+		{"limit exceeded", 1024, errors.KindLimitExceeded},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			client := NewReadingClient(ts.URL, utils.WithMaxResponseSize(testCase.maxResponseSize))
+			res, err := client.AllReadings(context.Background(), 0, -1)
+			streamed := 0
+			_, streamErr := client.AllReadingsStream(context.Background(), 0, -1, func(r dtos.BaseReading) errors.EdgeX {
+				streamed++
+				return nil
+			})
+			if testCase.expectedErrKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrKind, errors.Kind(err))
+				require.Error(t, streamErr)
+				assert.Equal(t, testCase.expectedErrKind, errors.Kind(streamErr))
+				assert.Less(t, streamed, len(readings))
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, res.Readings, len(readings))
+				require.NoError(t, streamErr)
+				assert.Equal(t, len(readings), streamed)
+			}
+		})
+	}
+}
+
+func newTestReading(t *testing.T, deviceName string, resourceName string, value int32) dtos.BaseReading {
+	reading, err := dtos.NewSimpleReading("profile", deviceName, resourceName, common.ValueTypeInt32, value)
+	require.NoError(t, err)
+	return reading
+}
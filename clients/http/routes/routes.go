@@ -0,0 +1,290 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package routes describes the routes of the EdgeX service APIs, and builds and matches request paths against their
+// templates, e.g. /api/v2/device/name/{name}.
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// Route describes a route of the EdgeX service APIs by its template and the HTTP methods it accepts
+type Route struct {
+	Template string
+	Methods  []string
+	segments []string
+}
+
+func newRoute(template string, methods ...string) Route {
+	return Route{Template: template, Methods: methods, segments: splitPath(template)}
+}
+
+// Params returns the names of the parameters of the route template in order, e.g. name for /api/v2/device/name/{name}
+func (r Route) Params() []string {
+	var params []string
+	for _, segment := range r.segments {
+		if name, ok := paramName(segment); ok {
+			params = append(params, name)
+		}
+	}
+	return params
+}
+
+// Allows tells whether the route accepts the HTTP method
+func (r Route) Allows(method string) bool {
+	for _, m := range r.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// registry lists the routes of the EdgeX service APIs served by at least one service
+var registry = []Route{
+	newRoute(common.ApiEventProfileNameDeviceNameSourceNameRoute, http.MethodPost),
+	newRoute(common.ApiAllEventRoute, http.MethodGet),
+	newRoute(common.ApiEventIdRoute, http.MethodGet, http.MethodDelete),
+	newRoute(common.ApiEventCountRoute, http.MethodGet),
+	newRoute(common.ApiEventCountByDeviceNameRoute, http.MethodGet),
+	newRoute(common.ApiEventByDeviceNameRoute, http.MethodGet, http.MethodDelete),
+	newRoute(common.ApiEventByTimeRangeRoute, http.MethodGet),
+	newRoute(common.ApiEventByAgeRoute, http.MethodDelete),
+
+	newRoute(common.ApiAllReadingRoute, http.MethodGet),
+	newRoute(common.ApiReadingCountRoute, http.MethodGet),
+	newRoute(common.ApiReadingCountByDeviceNameRoute, http.MethodGet),
+	newRoute(common.ApiReadingByDeviceNameRoute, http.MethodGet),
+	newRoute(common.ApiReadingByResourceNameRoute, http.MethodGet),
+	newRoute(common.ApiReadingByTimeRangeRoute, http.MethodGet),
+	newRoute(common.ApiReadingByResourceNameAndTimeRangeRoute, http.MethodGet),
+	newRoute(common.ApiReadingByDeviceNameAndResourceNameRoute, http.MethodGet),
+	newRoute(common.ApiReadingByDeviceNameAndResourceNameAndTimeRangeRoute, http.MethodGet),
+	newRoute(common.ApiReadingByDeviceNameAndTimeRangeRoute, http.MethodGet),
+
+	newRoute(common.ApiDeviceProfileRoute, http.MethodPost, http.MethodPut),
+	newRoute(common.ApiDeviceProfileBasicInfoRoute, http.MethodPatch),
+	newRoute(common.ApiDeviceProfileDeviceCommandRoute, http.MethodPost, http.MethodPatch),
+	newRoute(common.ApiDeviceProfileResourceRoute, http.MethodPost, http.MethodPatch),
+	newRoute(common.ApiDeviceProfileUploadFileRoute, http.MethodPost, http.MethodPut),
+	newRoute(common.ApiDeviceProfileByNameRoute, http.MethodGet, http.MethodDelete),
+	newRoute(common.ApiDeviceProfileDeviceCommandByNameRoute, http.MethodDelete),
+	newRoute(common.ApiDeviceProfileResourceByNameRoute, http.MethodDelete),
+	newRoute(common.ApiDeviceProfileByIdRoute, http.MethodGet, http.MethodDelete),
+	newRoute(common.ApiAllDeviceProfileRoute, http.MethodGet),
+	newRoute(common.ApiDeviceProfileByManufacturerRoute, http.MethodGet),
+	newRoute(common.ApiDeviceProfileByModelRoute, http.MethodGet),
+	newRoute(common.ApiDeviceProfileByManufacturerAndModelRoute, http.MethodGet),
+	newRoute(common.ApiDeviceResourceByProfileAndResourceRoute, http.MethodGet),
+
+	newRoute(common.ApiDeviceServiceRoute, http.MethodPost, http.MethodPatch),
+	newRoute(common.ApiAllDeviceServiceRoute, http.MethodGet),
+	newRoute(common.ApiDeviceServiceByNameRoute, http.MethodGet, http.MethodDelete),
+	newRoute(common.ApiDeviceServiceByIdRoute, http.MethodGet, http.MethodDelete),
+
+	newRoute(common.ApiDeviceRoute, http.MethodPost, http.MethodPatch),
+	newRoute(common.ApiAllDeviceRoute, http.MethodGet),
+	newRoute(common.ApiDeviceIdExistsRoute, http.MethodGet),
+	newRoute(common.ApiDeviceNameExistsRoute, http.MethodGet),
+	newRoute(common.ApiDeviceByIdRoute, http.MethodGet, http.MethodDelete),
+	newRoute(common.ApiDeviceByNameRoute, http.MethodGet, http.MethodDelete),
+	newRoute(common.ApiDeviceByProfileIdRoute, http.MethodGet),
+	newRoute(common.ApiDeviceByProfileNameRoute, http.MethodGet),
+	newRoute(common.ApiDeviceByServiceIdRoute, http.MethodGet),
+	newRoute(common.ApiDeviceByServiceNameRoute, http.MethodGet),
+	newRoute(common.ApiDeviceNameCommandNameRoute, http.MethodGet, http.MethodPut),
+
+	newRoute(common.ApiProvisionWatcherRoute, http.MethodPost, http.MethodPatch),
+	newRoute(common.ApiAllProvisionWatcherRoute, http.MethodGet),
+	newRoute(common.ApiProvisionWatcherByIdRoute, http.MethodGet, http.MethodDelete),
+	newRoute(common.ApiProvisionWatcherByNameRoute, http.MethodGet, http.MethodDelete),
+	newRoute(common.ApiProvisionWatcherByProfileNameRoute, http.MethodGet),
+	newRoute(common.ApiProvisionWatcherByServiceNameRoute, http.MethodGet),
+
+	newRoute(common.ApiSubscriptionRoute, http.MethodPost, http.MethodPatch),
+	newRoute(common.ApiAllSubscriptionRoute, http.MethodGet),
+	newRoute(common.ApiSubscriptionByNameRoute, http.MethodGet, http.MethodDelete),
+	newRoute(common.ApiSubscriptionByCategoryRoute, http.MethodGet),
+	newRoute(common.ApiSubscriptionByLabelRoute, http.MethodGet),
+	newRoute(common.ApiSubscriptionByReceiverRoute, http.MethodGet),
+
+	newRoute(common.ApiNotificationCleanupRoute, http.MethodDelete),
+	newRoute(common.ApiNotificationCleanupByAgeRoute, http.MethodDelete),
+	newRoute(common.ApiNotificationRoute, http.MethodPost),
+	newRoute(common.ApiNotificationByTimeRangeRoute, http.MethodGet),
+	newRoute(common.ApiNotificationByAgeRoute, http.MethodDelete),
+	newRoute(common.ApiNotificationByCategoryRoute, http.MethodGet),
+	newRoute(common.ApiNotificationByLabelRoute, http.MethodGet),
+	newRoute(common.ApiNotificationByIdRoute, http.MethodGet, http.MethodDelete),
+	newRoute(common.ApiNotificationByStatusRoute, http.MethodGet),
+	newRoute(common.ApiNotificationBySubscriptionNameRoute, http.MethodGet),
+
+	newRoute(common.ApiTransmissionByIdRoute, http.MethodGet),
+	newRoute(common.ApiTransmissionByAgeRoute, http.MethodDelete),
+	newRoute(common.ApiAllTransmissionRoute, http.MethodGet),
+	newRoute(common.ApiTransmissionBySubscriptionNameRoute, http.MethodGet),
+	newRoute(common.ApiTransmissionByTimeRangeRoute, http.MethodGet),
+	newRoute(common.ApiTransmissionByStatusRoute, http.MethodGet),
+	newRoute(common.ApiTransmissionByNotificationIdRoute, http.MethodGet),
+
+	newRoute(common.ApiConfigRoute, http.MethodGet),
+	newRoute(common.ApiPingRoute, http.MethodGet),
+	newRoute(common.ApiVersionRoute, http.MethodGet),
+	newRoute(common.ApiSecretRoute, http.MethodPost),
+	newRoute(common.ApiUnitsOfMeasureRoute, http.MethodGet),
+
+	newRoute(common.ApiDeviceCallbackRoute, http.MethodPost, http.MethodPut),
+	newRoute(common.ApiDeviceCallbackNameRoute, http.MethodDelete),
+	newRoute(common.ApiProfileCallbackRoute, http.MethodPut),
+	newRoute(common.ApiProfileCallbackNameRoute, http.MethodDelete),
+	newRoute(common.ApiWatcherCallbackRoute, http.MethodPost, http.MethodPut),
+	newRoute(common.ApiWatcherCallbackNameRoute, http.MethodDelete),
+	newRoute(common.ApiServiceCallbackRoute, http.MethodPut),
+	newRoute(common.ApiDiscoveryRoute, http.MethodPost),
+	newRoute(common.ApiDiscoveryByIdRoute, http.MethodGet, http.MethodDelete),
+	newRoute(common.ApiDeviceValidationRoute, http.MethodPost),
+
+	newRoute(common.ApiIntervalRoute, http.MethodPost, http.MethodPatch),
+	newRoute(common.ApiAllIntervalRoute, http.MethodGet),
+	newRoute(common.ApiIntervalByNameRoute, http.MethodGet, http.MethodDelete),
+	newRoute(common.ApiIntervalActionRoute, http.MethodPost, http.MethodPatch),
+	newRoute(common.ApiAllIntervalActionRoute, http.MethodGet),
+	newRoute(common.ApiIntervalActionByNameRoute, http.MethodGet, http.MethodDelete),
+	newRoute(common.ApiIntervalActionByTargetRoute, http.MethodGet),
+
+	newRoute(common.ApiOperationRoute, http.MethodPost),
+	newRoute(common.ApiHealthRoute, http.MethodGet),
+	newRoute(common.ApiMultiConfigRoute, http.MethodGet),
+}
+
+// All returns the registered routes sorted by template
+func All() []Route {
+	routes := make([]Route, len(registry))
+	copy(routes, registry)
+	sort.Slice(routes, func(i, j int) bool { return routes[i].Template < routes[j].Template })
+	return routes
+}
+
+// Lookup returns the registered route with the template and true, or false if the template is not registered
+func Lookup(template string) (Route, bool) {
+	for _, route := range registry {
+		if route.Template == template {
+			return route, true
+		}
+	}
+	return Route{}, false
+}
+
+// Build expands the route template with the parameters, e.g. Build(common.ApiDeviceByNameRoute,
+// map[string]string{common.Name: name}). Each parameter value is escaped so that both path and query unescaping, as
+// done by the EdgeX services, restore it, even if it holds a slash, a space or a plus sign. A missing or empty
+// parameter and a parameter which is not in the template fail with a KindContractInvalid error.
+func Build(template string, params map[string]string) (string, errors.EdgeX) {
+	segments := splitPath(template)
+	used := 0
+	for i, segment := range segments {
+		name, ok := paramName(segment)
+		if !ok {
+			continue
+		}
+		value := params[name]
+		if value == "" {
+			return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("parameter %s of route %s is required", name, template), nil)
+		}
+		segments[i] = escape(value)
+		used++
+	}
+	if used != len(params) {
+		for name := range params {
+			if !strings.Contains(template, "{"+name+"}") {
+				return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("route %s has no parameter %s", template, name), nil)
+			}
+		}
+	}
+	return "/" + strings.Join(segments, "/"), nil
+}
+
+// Match returns the registered route matching the request path with its unescaped parameters, and true, or false if
+// no route matches. The path is matched on its trailing segments so that a path prefix of the base URL is ignored.
+// When several routes match, the one with the most literal segments wins, e.g. /api/v2/event/device/name/{name} over
+// /api/v2/event/{profileName}/{deviceName}/{sourceName}.
+func Match(requestPath string) (Route, map[string]string, bool) {
+	pathSegments := splitPath(requestPath)
+	var matched *Route
+	matchedLiterals := -1
+	for i, route := range registry {
+		if len(route.segments) > len(pathSegments) {
+			continue
+		}
+		literals, ok := matchSegments(route.segments, pathSegments[len(pathSegments)-len(route.segments):])
+		if ok && literals > matchedLiterals {
+			matched, matchedLiterals = &registry[i], literals
+		}
+	}
+	if matched == nil {
+		return Route{}, nil, false
+	}
+
+	params := make(map[string]string)
+	trailing := pathSegments[len(pathSegments)-len(matched.segments):]
+	for i, segment := range matched.segments {
+		if name, ok := paramName(segment); ok {
+			params[name] = unescape(trailing[i])
+		}
+	}
+	return *matched, params, true
+}
+
+// matchSegments reports whether the path segments match the template segments and how many literal segments matched
+func matchSegments(template []string, pathSegments []string) (int, bool) {
+	literals := 0
+	for i, segment := range template {
+		if _, ok := paramName(segment); ok {
+			if pathSegments[i] == "" {
+				return 0, false
+			}
+			continue
+		}
+		if segment != pathSegments[i] {
+			return 0, false
+		}
+		literals++
+	}
+	return literals, true
+}
+
+func splitPath(p string) []string {
+	return strings.Split(strings.Trim(p, "/"), "/")
+}
+
+// paramName returns the name of the template segment if it is a parameter, e.g. name for {name}
+func paramName(segment string) (string, bool) {
+	if len(segment) > 2 && strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+		return segment[1 : len(segment)-1], true
+	}
+	return "", false
+}
+
+// escape escapes the value as a query component, except spaces which are escaped as %20 instead of +, so that the
+// result is a valid path segment which both url.PathUnescape and url.QueryUnescape decode to the value
+func escape(value string) string {
+	return strings.ReplaceAll(url.QueryEscape(value), "+", "%20")
+}
+
+func unescape(segment string) string {
+	value, err := url.PathUnescape(segment)
+	if err != nil {
+		return segment
+	}
+	return value
+}
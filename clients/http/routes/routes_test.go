@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package routes
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		params   map[string]string
+		expected string
+	}{
+		{"static route", common.ApiAllDeviceRoute, nil, common.ApiAllDeviceRoute},
+		{"one parameter", common.ApiDeviceByNameRoute, map[string]string{common.Name: "Random-Integer-Device"}, common.ApiDeviceRoute + "/name/Random-Integer-Device"},
+		{"several parameters", common.ApiReadingByDeviceNameAndResourceNameRoute, map[string]string{common.Name: "device", common.ResourceName: "Int8"}, common.ApiReadingRoute + "/device/name/device/resourceName/Int8"},
+		{"escaped parameters", common.ApiDeviceNameCommandNameRoute, map[string]string{common.Name: "my device/1", common.Command: "a+b%"}, common.ApiDeviceRoute + "/name/my%20device%2F1/a%2Bb%25"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := Build(testCase.template, testCase.params)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
+
+func TestBuildEscapingIsReversible(t *testing.T) {
+	value := "a b+c/d%e?f#g"
+	result, err := Build(common.ApiDeviceByNameRoute, map[string]string{common.Name: value})
+	require.NoError(t, err)
+	segment := result[len(common.ApiDeviceRoute+"/name/"):]
+
+	pathUnescaped, err2 := url.PathUnescape(segment)
+	require.NoError(t, err2)
+	assert.Equal(t, value, pathUnescaped)
+	queryUnescaped, err2 := url.QueryUnescape(segment)
+	require.NoError(t, err2)
+	assert.Equal(t, value, queryUnescaped)
+}
+
+func TestBuildError(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		params   map[string]string
+	}{
+		{"missing parameter", common.ApiDeviceNameCommandNameRoute, map[string]string{common.Name: "device"}},
+		{"empty parameter", common.ApiDeviceByNameRoute, map[string]string{common.Name: ""}},
+		{"unknown parameter", common.ApiDeviceByNameRoute, map[string]string{common.Name: "device", common.Id: "id"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Build(testCase.template, testCase.params)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name             string
+		requestPath      string
+		expectedTemplate string
+		expectedParams   map[string]string
+	}{
+		{"static route", common.ApiAllDeviceRoute, common.ApiAllDeviceRoute, map[string]string{}},
+		{"route with a parameter", common.ApiDeviceRoute + "/name/Random-Integer-Device", common.ApiDeviceByNameRoute, map[string]string{common.Name: "Random-Integer-Device"}},
+		{"literal segments take precedence", common.ApiEventRoute + "/device/name/device", common.ApiEventByDeviceNameRoute, map[string]string{common.Name: "device"}},
+		{"parameters only", common.ApiEventRoute + "/profile/device/source", common.ApiEventProfileNameDeviceNameSourceNameRoute,
+			map[string]string{common.ProfileName: "profile", common.DeviceName: "device", common.SourceName: "source"}},
+		{"escaped parameters", common.ApiDeviceRoute + "/name/my%20device%2F1/a%2Bb", common.ApiDeviceNameCommandNameRoute, map[string]string{common.Name: "my device/1", common.Command: "a+b"}},
+		{"base URL path prefix", "/core-data" + common.ApiEventRoute + "/id/82eb2e26", common.ApiEventIdRoute, map[string]string{common.Id: "82eb2e26"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			route, params, ok := Match(testCase.requestPath)
+			require.True(t, ok)
+			assert.Equal(t, testCase.expectedTemplate, route.Template)
+			assert.Equal(t, testCase.expectedParams, params)
+		})
+	}
+
+	_, _, ok := Match("/custom/route")
+	assert.False(t, ok)
+}
+
+func TestBuildAndMatchAllRoutes(t *testing.T) {
+	for _, route := range All() {
+		params := make(map[string]string)
+		for _, name := range route.Params() {
+			params[name] = "value " + name
+		}
+		requestPath, err := Build(route.Template, params)
+		require.NoError(t, err, route.Template)
+
+		matched, matchedParams, ok := Match(requestPath)
+		require.True(t, ok, route.Template)
+		assert.Equal(t, route.Template, matched.Template)
+		assert.Equal(t, params, matchedParams)
+		assert.NotEmpty(t, route.Methods, route.Template)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	route, ok := Lookup(common.ApiEventIdRoute)
+	require.True(t, ok)
+	assert.Equal(t, []string{common.Id}, route.Params())
+	assert.True(t, route.Allows(http.MethodDelete))
+	assert.False(t, route.Allows(http.MethodPost))
+
+	_, ok = Lookup(common.ApiEventRoute)
+	assert.False(t, ok)
+}
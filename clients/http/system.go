@@ -20,18 +20,20 @@ import (
 
 type SystemManagementClient struct {
 	baseUrl string
+	opts    []utils.ClientOption
 }
 
-func NewSystemManagementClient(baseUrl string) interfaces.SystemManagementClient {
+func NewSystemManagementClient(baseUrl string, opts ...utils.ClientOption) interfaces.SystemManagementClient {
 	return &SystemManagementClient{
 		baseUrl: baseUrl,
+		opts:    opts,
 	}
 }
 
 func (smc *SystemManagementClient) GetHealth(ctx context.Context, services []string) (res []dtoCommon.BaseWithServiceNameResponse, err errors.EdgeX) {
 	requestParams := url.Values{}
 	requestParams.Set(common.Services, strings.Join(services, common.CommaSeparator))
-	err = utils.GetRequest(ctx, &res, smc.baseUrl, common.ApiHealthRoute, requestParams)
+	err = utils.GetRequest(ctx, &res, smc.baseUrl, common.ApiHealthRoute, requestParams, smc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -42,7 +44,7 @@ func (smc *SystemManagementClient) GetHealth(ctx context.Context, services []str
 func (smc *SystemManagementClient) GetConfig(ctx context.Context, services []string) (res []dtoCommon.BaseWithConfigResponse, err errors.EdgeX) {
 	requestParams := url.Values{}
 	requestParams.Set(common.Services, strings.Join(services, common.CommaSeparator))
-	err = utils.GetRequest(ctx, &res, smc.baseUrl, common.ApiMultiConfigRoute, requestParams)
+	err = utils.GetRequest(ctx, &res, smc.baseUrl, common.ApiMultiConfigRoute, requestParams, smc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -51,7 +53,7 @@ func (smc *SystemManagementClient) GetConfig(ctx context.Context, services []str
 }
 
 func (smc *SystemManagementClient) DoOperation(ctx context.Context, reqs []requests.OperationRequest) (res []dtoCommon.BaseResponse, err errors.EdgeX) {
-	err = utils.PostRequestWithRawData(ctx, &res, smc.baseUrl, common.ApiOperationRoute, nil, reqs)
+	err = utils.PostRequestWithRawData(ctx, &res, smc.baseUrl, common.ApiOperationRoute, nil, reqs, smc.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
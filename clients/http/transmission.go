@@ -8,9 +8,9 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -21,19 +21,24 @@ import (
 
 type TransmissionClient struct {
 	baseUrl string
+	opts    []utils.ClientOption
 }
 
 // NewTransmissionClient creates an instance of TransmissionClient
-func NewTransmissionClient(baseUrl string) interfaces.TransmissionClient {
+func NewTransmissionClient(baseUrl string, opts ...utils.ClientOption) interfaces.TransmissionClient {
 	return &TransmissionClient{
 		baseUrl: baseUrl,
+		opts:    opts,
 	}
 }
 
 // TransmissionById query transmission by id.
 func (client *TransmissionClient) TransmissionById(ctx context.Context, id string) (res responses.TransmissionResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiTransmissionRoute, common.Id, id)
-	err = utils.GetRequest(ctx, &res, client.baseUrl, path, nil)
+	requestPath, err := routes.Build(common.ApiTransmissionByIdRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -42,11 +47,14 @@ func (client *TransmissionClient) TransmissionById(ctx context.Context, id strin
 
 // TransmissionsByTimeRange query transmissions with time range, offset and limit
 func (client *TransmissionClient) TransmissionsByTimeRange(ctx context.Context, start int, end int, offset int, limit int) (res responses.MultiTransmissionsResponse, err errors.EdgeX) {
-	requestPath := path.Join(common.ApiTransmissionRoute, common.Start, strconv.Itoa(start), common.End, strconv.Itoa(end))
+	requestPath, err := routes.Build(common.ApiTransmissionByTimeRangeRoute, map[string]string{common.Start: strconv.Itoa(start), common.End: strconv.Itoa(end)})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -58,7 +66,7 @@ func (client *TransmissionClient) AllTransmissions(ctx context.Context, offset i
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllTransmissionRoute, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllTransmissionRoute, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -67,11 +75,14 @@ func (client *TransmissionClient) AllTransmissions(ctx context.Context, offset i
 
 // TransmissionsByStatus queries transmissions with status, offset and limit
 func (client *TransmissionClient) TransmissionsByStatus(ctx context.Context, status string, offset int, limit int) (res responses.MultiTransmissionsResponse, err errors.EdgeX) {
-	requestPath := path.Join(common.ApiTransmissionRoute, common.Status, status)
+	requestPath, err := routes.Build(common.ApiTransmissionByStatusRoute, map[string]string{common.Status: status})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -80,8 +91,11 @@ func (client *TransmissionClient) TransmissionsByStatus(ctx context.Context, sta
 
 // DeleteProcessedTransmissionsByAge deletes the processed transmissions if the current timestamp minus their created timestamp is less than the age parameter.
 func (client *TransmissionClient) DeleteProcessedTransmissionsByAge(ctx context.Context, age int) (res dtoCommon.BaseResponse, err errors.EdgeX) {
-	path := path.Join(common.ApiTransmissionRoute, common.Age, strconv.Itoa(age))
-	err = utils.DeleteRequest(ctx, &res, client.baseUrl, path)
+	requestPath, err := routes.Build(common.ApiTransmissionByAgeRoute, map[string]string{common.Age: strconv.Itoa(age)})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, client.baseUrl, requestPath, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -90,11 +104,14 @@ func (client *TransmissionClient) DeleteProcessedTransmissionsByAge(ctx context.
 
 // TransmissionsBySubscriptionName query transmissions with subscriptionName, offset and limit
 func (client *TransmissionClient) TransmissionsBySubscriptionName(ctx context.Context, subscriptionName string, offset int, limit int) (res responses.MultiTransmissionsResponse, err errors.EdgeX) {
-	requestPath := path.Join(common.ApiTransmissionRoute, common.Subscription, common.Name, subscriptionName)
+	requestPath, err := routes.Build(common.ApiTransmissionBySubscriptionNameRoute, map[string]string{common.Name: subscriptionName})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -103,11 +120,14 @@ func (client *TransmissionClient) TransmissionsBySubscriptionName(ctx context.Co
 
 // TransmissionsByNotificationId query transmissions with notification id, offset and limit
 func (client *TransmissionClient) TransmissionsByNotificationId(ctx context.Context, id string, offset int, limit int) (res responses.MultiTransmissionsResponse, err errors.EdgeX) {
-	requestPath := path.Join(common.ApiTransmissionRoute, common.Notification, common.Id, id)
+	requestPath, err := routes.Build(common.ApiTransmissionByNotificationIdRoute, map[string]string{common.Id: id})
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.opts...)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
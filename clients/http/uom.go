@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+type UnitsOfMeasureClient struct {
+	baseUrl string
+	opts    []utils.ClientOption
+}
+
+// NewUnitsOfMeasureClient creates an instance of UnitsOfMeasureClient
+func NewUnitsOfMeasureClient(baseUrl string, opts ...utils.ClientOption) interfaces.UnitsOfMeasureClient {
+	return &UnitsOfMeasureClient{
+		baseUrl: baseUrl,
+		opts:    opts,
+	}
+}
+
+// UnitsOfMeasure decodes the untyped catalog of responses.UnitsOfMeasureResponse into a responses.UnitsOfMeasureCatalogResponse
+func (client *UnitsOfMeasureClient) UnitsOfMeasure(ctx context.Context) (res responses.UnitsOfMeasureCatalogResponse, err errors.EdgeX) {
+	err = utils.GetRequest(ctx, &res, client.baseUrl, common.ApiUnitsOfMeasureRoute, nil, client.opts...)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
@@ -0,0 +1,37 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+)
+
+func TestUnitsOfMeasure(t *testing.T) {
+	expected := dtos.UnitsOfMeasure{
+		Source: "edgex",
+		Units: map[string]dtos.UnitGroup{
+			"temperature": {Source: "www.weather.com", Values: []string{"C", "F", "K"}},
+		},
+	}
+	ts := newTestServer(http.MethodGet, common.ApiUnitsOfMeasureRoute, responses.NewUnitsOfMeasureResponse("", "", http.StatusOK, expected))
+	defer ts.Close()
+
+	client := NewUnitsOfMeasureClient(ts.URL)
+	res, err := client.UnitsOfMeasure(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, expected, res.Uom)
+	assert.True(t, res.Uom.Contains("F"))
+}
@@ -15,7 +15,6 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/url"
-	"path"
 	"path/filepath"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -45,37 +44,147 @@ func correlatedId(ctx context.Context) string {
 }
 
 // Helper method to get the body from the response after making the request
-func getBody(resp *http.Response) ([]byte, errors.EdgeX) {
-	body, err := ioutil.ReadAll(resp.Body)
+func getBody(resp *http.Response, o *clientOptions) ([]byte, errors.EdgeX) {
+	body, err := ioutil.ReadAll(limitReader(resp.Body, o.maxResponseSize))
 	if err != nil {
+		if errors.Kind(err) == errors.KindLimitExceeded {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
 		return body, errors.NewCommonEdgeX(errors.KindIOError, "failed to get the body from the response", err)
 	}
 	return body, nil
 }
 
-// Helper method to make the request and return the response
-func makeRequest(req *http.Request) (*http.Response, errors.EdgeX) {
-	client := &http.Client{}
+// limitReader returns a reader which fails with a KindLimitExceeded error once more than maxSize bytes have been read
+// from r. A maxSize <= 0 leaves r unlimited.
+func limitReader(r io.Reader, maxSize int64) io.Reader {
+	if maxSize <= 0 {
+		return r
+	}
+	return &limitedReader{r: r, remaining: maxSize, maxSize: maxSize}
+}
+
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+	maxSize   int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		// The limit has been reached, so any further byte means the body is too large
+		var probe [1]byte
+		n, err := l.r.Read(probe[:])
+		if n > 0 {
+			return 0, errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("the response body exceeds the maximum size of %d bytes", l.maxSize), nil)
+		}
+		return 0, err
+	}
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	return n, err
+}
+
+// Helper method to make the request and return the response.
+// The span hooks are invoked around the request, the trace context is propagated as the request headers and the
+// metrics of the request are recorded until the response body is closed.
+func makeRequest(ctx context.Context, req *http.Request, o *clientOptions) (*http.Response, errors.EdgeX) {
+	span := Span{Method: req.Method, Route: routeTemplate(req.URL.EscapedPath())}
+	ctx = o.startSpan(ctx, span)
+	setTraceHeaders(ctx, req)
+	recorder := o.metrics.startRequest(span.Method, span.Route, req.ContentLength)
+
+	statusCode := 0
+	resp, edgexErr := doRequest(ctx, req, o, recorder)
+	if edgexErr == nil {
+		statusCode = resp.StatusCode
+		// Count the bytes received before the response body is decompressed
+		resp.Body = recorder.wrapBody(resp.Body)
+		if edgexErr = decompressResponse(resp); edgexErr != nil {
+			resp.Body.Close()
+		}
+	}
+
+	var errKind errors.ErrKind
+	if edgexErr != nil {
+		errKind = errors.Kind(edgexErr)
+	} else if statusCode > http.StatusMultiStatus && statusCode != http.StatusNotModified {
+		errKind = errors.KindMapping(statusCode)
+	}
+	recorder.respond(statusCode, errKind)
+	o.endSpan(ctx, span, statusCode, errKind)
+	if edgexErr != nil {
+		recorder.finish()
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	return resp, nil
+}
+
+// doRequest waits until the request is allowed by the rate and concurrency limits of its base URL, then sends it with
+// the http.Client configured by the options, the latency recorded starting once the request is allowed. The concurrency
+// slot of the request is released when the response body is closed.
+func doRequest(ctx context.Context, req *http.Request, o *clientOptions, recorder *requestRecorder) (*http.Response, errors.EdgeX) {
+	if o.compression != "" && req.Header.Get(common.AcceptEncoding) == "" {
+		req.Header.Set(common.AcceptEncoding, acceptedEncodings)
+	}
+
+	client, edgexErr := o.httpClient(req)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	release, edgexErr := o.acquireLimits(ctx, req.URL)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	recorder.send()
 	resp, err := client.Do(req)
 	if err != nil {
+		release()
 		return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "failed to send a http request", err)
 	}
 	if resp == nil {
+		release()
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "the response should not be a nil", nil)
 	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
 	return resp, nil
 }
 
-func createRequest(ctx context.Context, httpMethod string, baseUrl string, requestPath string, requestParams url.Values) (*http.Request, errors.EdgeX) {
+// parseRequestUrl joins the path of the base URL with the request path, the base URL being resolved by the endpoint
+// resolver of the options if there is one. A base URL with the unix scheme, e.g. unix:///run/edgex/core-data.sock,
+// addresses a service listening on that unix domain socket and is converted to a http URL whose host identifies the socket.
+func parseRequestUrl(ctx context.Context, baseUrl string, requestPath string, o *clientOptions) (*url.URL, errors.EdgeX) {
+	baseUrl, edgexErr := o.resolveBaseUrl(ctx, baseUrl)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
 	u, err := url.Parse(baseUrl)
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "fail to parse baseUrl", err)
 	}
-	u.Path = path.Join(u.Path, requestPath)
+	if u.Scheme != UnixScheme {
+		return joinPath(u, requestPath), nil
+	}
+
+	host, edgexErr := unixSocketHost(u.Path)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	return joinPath(&url.URL{Scheme: "http", Host: host}, requestPath), nil
+}
+
+func createRequest(ctx context.Context, httpMethod string, baseUrl string, requestPath string, requestParams url.Values, o *clientOptions) (*http.Request, errors.EdgeX) {
+	u, edgexErr := parseRequestUrl(ctx, baseUrl, requestPath, o)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
 	if requestParams != nil {
 		u.RawQuery = requestParams.Encode()
 	}
-	req, err := http.NewRequest(httpMethod, u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, httpMethod, u.String(), nil)
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
 	}
@@ -83,12 +192,11 @@ func createRequest(ctx context.Context, httpMethod string, baseUrl string, reque
 	return req, nil
 }
 
-func createRequestWithRawDataAndParams(ctx context.Context, httpMethod string, baseUrl string, requestPath string, requestParams url.Values, data interface{}) (*http.Request, errors.EdgeX) {
-	u, err := url.Parse(baseUrl)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "fail to parse baseUrl", err)
+func createRequestWithRawDataAndParams(ctx context.Context, httpMethod string, baseUrl string, requestPath string, requestParams url.Values, data interface{}, o *clientOptions) (*http.Request, errors.EdgeX) {
+	u, edgexErr := parseRequestUrl(ctx, baseUrl, requestPath, o)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
 	}
-	u.Path = path.Join(u.Path, requestPath)
 	if requestParams != nil {
 		u.RawQuery = requestParams.Encode()
 	}
@@ -102,21 +210,20 @@ func createRequestWithRawDataAndParams(ctx context.Context, httpMethod string, b
 		content = common.ContentTypeJSON
 	}
 
-	req, err := http.NewRequest(httpMethod, u.String(), bytes.NewReader(jsonEncodedData))
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
+	req, edgexErr := newRequestWithBody(ctx, httpMethod, u.String(), jsonEncodedData, o)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
 	}
 	req.Header.Set(common.ContentType, content)
 	req.Header.Set(common.CorrelationHeader, correlatedId(ctx))
 	return req, nil
 }
 
-func createRequestWithRawData(ctx context.Context, httpMethod string, baseUrl string, requestPath string, requestParams url.Values, data interface{}) (*http.Request, errors.EdgeX) {
-	u, err := url.Parse(baseUrl)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "fail to parse baseUrl", err)
+func createRequestWithRawData(ctx context.Context, httpMethod string, baseUrl string, requestPath string, requestParams url.Values, data interface{}, o *clientOptions) (*http.Request, errors.EdgeX) {
+	u, edgexErr := parseRequestUrl(ctx, baseUrl, requestPath, o)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
 	}
-	u.Path = path.Join(u.Path, requestPath)
 	if requestParams != nil {
 		u.RawQuery = requestParams.Encode()
 	}
@@ -131,43 +238,57 @@ func createRequestWithRawData(ctx context.Context, httpMethod string, baseUrl st
 		content = common.ContentTypeJSON
 	}
 
-	req, err := http.NewRequest(httpMethod, u.String(), bytes.NewReader(jsonEncodedData))
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
+	req, edgexErr := newRequestWithBody(ctx, httpMethod, u.String(), jsonEncodedData, o)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
 	}
 	req.Header.Set(common.ContentType, content)
 	req.Header.Set(common.CorrelationHeader, correlatedId(ctx))
 	return req, nil
 }
 
-func createRequestWithEncodedData(ctx context.Context, httpMethod string, baseUrl string, requestPath string, data []byte, encoding string) (*http.Request, errors.EdgeX) {
-	u, err := url.Parse(baseUrl)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "fail to parse baseUrl", err)
+func createRequestWithEncodedData(ctx context.Context, httpMethod string, baseUrl string, requestPath string, data []byte, encoding string, o *clientOptions) (*http.Request, errors.EdgeX) {
+	u, edgexErr := parseRequestUrl(ctx, baseUrl, requestPath, o)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
 	}
-	u.Path = path.Join(u.Path, requestPath)
 
 	content := encoding
 	if content == "" {
 		content = FromContext(ctx, common.ContentType)
 	}
 
-	req, err := http.NewRequest(httpMethod, u.String(), bytes.NewReader(data))
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
+	req, edgexErr := newRequestWithBody(ctx, httpMethod, u.String(), data, o)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
 	}
 	req.Header.Set(common.ContentType, content)
 	req.Header.Set(common.CorrelationHeader, correlatedId(ctx))
 	return req, nil
 }
 
-// createRequestFromFilePath creates multipart/form-data request with the specified file
-func createRequestFromFilePath(ctx context.Context, httpMethod string, baseUrl string, requestPath string, filePath string) (*http.Request, errors.EdgeX) {
-	u, err := url.Parse(baseUrl)
+// newRequestWithBody creates a http request with the specified body, which is compressed when enabled by the client options
+func newRequestWithBody(ctx context.Context, httpMethod string, requestUrl string, data []byte, o *clientOptions) (*http.Request, errors.EdgeX) {
+	body, contentEncoding, edgexErr := compressBody(data, o)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	req, err := http.NewRequestWithContext(ctx, httpMethod, requestUrl, bytes.NewReader(body))
 	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "fail to parse baseUrl", err)
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
+	}
+	if contentEncoding != "" {
+		req.Header.Set(common.ContentEncoding, contentEncoding)
+	}
+	return req, nil
+}
+
+// createRequestFromFilePath creates multipart/form-data request with the specified file
+func createRequestFromFilePath(ctx context.Context, httpMethod string, baseUrl string, requestPath string, filePath string, o *clientOptions) (*http.Request, errors.EdgeX) {
+	u, edgexErr := parseRequestUrl(ctx, baseUrl, requestPath, o)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
 	}
-	u.Path = path.Join(u.Path, requestPath)
 
 	fileContents, err := ioutil.ReadFile(filePath)
 	if err != nil {
@@ -186,9 +307,9 @@ func createRequestFromFilePath(ctx context.Context, httpMethod string, baseUrl s
 	}
 	writer.Close()
 
-	req, err := http.NewRequest(httpMethod, u.String(), body)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
+	req, edgexErr := newRequestWithBody(ctx, httpMethod, u.String(), body.Bytes(), o)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
 	}
 	req.Header.Set(common.ContentType, writer.FormDataContentType())
 	req.Header.Set(common.CorrelationHeader, correlatedId(ctx))
@@ -197,14 +318,14 @@ func createRequestFromFilePath(ctx context.Context, httpMethod string, baseUrl s
 
 // sendRequest will make a request with raw data to the specified URL.
 // It returns the body as a byte array if successful and an error otherwise.
-func sendRequest(ctx context.Context, req *http.Request) ([]byte, errors.EdgeX) {
-	resp, err := makeRequest(req)
+func sendRequest(ctx context.Context, req *http.Request, o *clientOptions) ([]byte, errors.EdgeX) {
+	resp, err := makeRequest(ctx, req, o)
 	if err != nil {
 		return nil, errors.NewCommonEdgeXWrapper(err)
 	}
 	defer resp.Body.Close()
 
-	bodyBytes, err := getBody(resp)
+	bodyBytes, err := getBody(resp, o)
 	if err != nil {
 		return nil, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -0,0 +1,87 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// acceptedEncodings lists the response content encodings which can be decoded transparently
+var acceptedEncodings = strings.Join([]string{common.ContentEncodingGzip, common.ContentEncodingDeflate}, ", ")
+
+// compressBody compresses the request body with the configured content encoding. The body is returned unchanged along
+// with an empty encoding if compression is disabled or the body is smaller than the configured threshold.
+func compressBody(data []byte, o *clientOptions) ([]byte, string, errors.EdgeX) {
+	if o.compression == "" || len(data) < o.compressionThreshold {
+		return data, "", nil
+	}
+
+	var buf bytes.Buffer
+	var writer io.WriteCloser
+	switch o.compression {
+	case common.ContentEncodingGzip:
+		writer = gzip.NewWriter(&buf)
+	case common.ContentEncodingDeflate:
+		writer = zlib.NewWriter(&buf)
+	default:
+		return nil, "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported content encoding %s", o.compression), nil)
+	}
+	if _, err := writer.Write(data); err != nil {
+		return nil, "", errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to %s compress the request body", o.compression), err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to %s compress the request body", o.compression), err)
+	}
+	return buf.Bytes(), o.compression, nil
+}
+
+// decompressResponse replaces the response body with a reader which decodes the body according to its
+// Content-Encoding header, so the callers always read the decoded content.
+func decompressResponse(resp *http.Response) errors.EdgeX {
+	var reader io.ReadCloser
+	var err error
+	encoding := strings.TrimSpace(strings.ToLower(resp.Header.Get(common.ContentEncoding)))
+	switch encoding {
+	case common.ContentEncodingGzip:
+		reader, err = gzip.NewReader(resp.Body)
+	case common.ContentEncodingDeflate:
+		reader, err = zlib.NewReader(resp.Body)
+	default:
+		return nil
+	}
+	if err == io.EOF {
+		// An encoded empty body carries no content at all
+		return nil
+	} else if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode the %s response body", encoding), err)
+	}
+
+	resp.Body = &decompressedBody{ReadCloser: reader, body: resp.Body}
+	resp.Header.Del(common.ContentEncoding)
+	resp.Header.Del(common.ContentLength)
+	resp.ContentLength = -1
+	return nil
+}
+
+// decompressedBody closes both the decoding reader and the underlying response body
+type decompressedBody struct {
+	io.ReadCloser
+	body io.Closer
+}
+
+func (d *decompressedBody) Close() error {
+	_ = d.ReadCloser.Close()
+	return d.body.Close()
+}
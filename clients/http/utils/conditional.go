@@ -0,0 +1,147 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// WithConditionalRequests makes GetRequest remember the ETag and Last-Modified headers of the responses, along with the
+// response bodies, for up to maxEntries URLs, the least recently used URL being evicted first.
+// Repeated reads of a URL send the If-None-Match and If-Modified-Since headers, and a 304 Not Modified response is
+// answered by decoding the cached body again, so the responses returned for a URL share no slice or map.
+// The cache is shared by all the clients using the same option.
+func WithConditionalRequests(maxEntries int) ClientOption {
+	cache := &conditionalCache{maxEntries: maxEntries, entries: make(map[string]*list.Element), order: list.New()}
+	return func(o *clientOptions) {
+		o.conditionalCache = cache
+	}
+}
+
+type conditionalEntry struct {
+	url          string
+	etag         string
+	lastModified string
+	body         []byte
+}
+
+// setInto decodes the cached response body into returnValuePointer
+func (e *conditionalEntry) setInto(returnValuePointer interface{}) errors.EdgeX {
+	if len(e.body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(e.body, returnValuePointer); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the cached response body", err)
+	}
+	return nil
+}
+
+// conditionalCache is a least recently used cache of the conditional entries keyed by URL
+type conditionalCache struct {
+	maxEntries int
+	mutex      sync.Mutex
+	entries    map[string]*list.Element
+	order      *list.List
+}
+
+func (c *conditionalCache) get(url string) (*conditionalEntry, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	element, ok := c.entries[url]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*conditionalEntry), true
+}
+
+func (c *conditionalCache) put(entry *conditionalEntry) {
+	if c.maxEntries <= 0 {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if element, ok := c.entries[entry.url]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[entry.url] = c.order.PushFront(entry)
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*conditionalEntry).url)
+	}
+}
+
+func (c *conditionalCache) remove(url string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if element, ok := c.entries[url]; ok {
+		c.order.Remove(element)
+		delete(c.entries, url)
+	}
+}
+
+// sendConditionalRequest sends the GET request with the validators of the cached response of its URL, if any, and
+// decodes the response into returnValuePointer, the cached response being used when the server replies 304.
+func sendConditionalRequest(ctx context.Context, returnValuePointer interface{}, req *http.Request, o *clientOptions) errors.EdgeX {
+	url := req.URL.String()
+	cached, ok := o.conditionalCache.get(url)
+	if ok {
+		if cached.etag != "" {
+			req.Header.Set(common.IfNoneMatch, cached.etag)
+		}
+		if cached.lastModified != "" {
+			req.Header.Set(common.IfModifiedSince, cached.lastModified)
+		}
+	}
+
+	resp, err := makeRequest(ctx, req, o)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := getBody(resp, o)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if resp.StatusCode == http.StatusNotModified && ok {
+		return cached.setInto(returnValuePointer)
+	}
+	if resp.StatusCode > http.StatusMultiStatus {
+		msg := fmt.Sprintf("request failed, status code: %d, err: %s", resp.StatusCode, string(body))
+		return errors.NewCommonEdgeX(errors.KindMapping(resp.StatusCode), msg, nil)
+	}
+
+	entry := &conditionalEntry{
+		url:          url,
+		etag:         resp.Header.Get(common.ETag),
+		lastModified: resp.Header.Get(common.LastModified),
+		body:         body,
+	}
+	// Check the response content length to avoid json unmarshal error
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, returnValuePointer); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
+		}
+	}
+	if entry.etag == "" && entry.lastModified == "" {
+		o.conditionalCache.remove(url)
+		return nil
+	}
+	o.conditionalCache.put(entry)
+	return nil
+}
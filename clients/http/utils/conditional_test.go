@@ -0,0 +1,162 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+)
+
+// conditionalServer serves a versioned response per path and replies 304 when the request validators match
+type conditionalServer struct {
+	mutex        sync.Mutex
+	version      int
+	lastModified time.Time
+	useETag      bool
+	full         int
+	notModified  int
+	validators   []string
+}
+
+func (s *conditionalServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	etag := `"` + r.URL.Path + "-" + string(rune('0'+s.version)) + `"`
+	s.validators = append(s.validators, r.Header.Get(common.IfNoneMatch)+"|"+r.Header.Get(common.IfModifiedSince))
+	if s.useETag {
+		w.Header().Set(common.ETag, etag)
+		if r.Header.Get(common.IfNoneMatch) == etag {
+			s.notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	} else {
+		w.Header().Set(common.LastModified, s.lastModified.UTC().Format(http.TimeFormat))
+		if since, err := http.ParseTime(r.Header.Get(common.IfModifiedSince)); err == nil && !s.lastModified.Truncate(time.Second).After(since) {
+			s.notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+	s.full++
+	_ = json.NewEncoder(w).Encode(dtoCommon.BaseWithServiceNameResponse{
+		BaseResponse: dtoCommon.NewBaseResponse("", r.URL.Path, http.StatusOK),
+		ServiceName:  string(rune('0' + s.version)),
+	})
+}
+
+func TestWithConditionalRequestsETag(t *testing.T) {
+	server := &conditionalServer{version: 1, useETag: true}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	option := WithConditionalRequests(10)
+	var res dtoCommon.BaseWithServiceNameResponse
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiAllDeviceProfileRoute, nil, option))
+	assert.Equal(t, "1", res.ServiceName)
+
+	// The cached response is reused when the server replies 304
+	var cached dtoCommon.BaseWithServiceNameResponse
+	require.NoError(t, GetRequest(context.Background(), &cached, ts.URL, common.ApiAllDeviceProfileRoute, nil, option))
+	assert.Equal(t, res, cached)
+	assert.Equal(t, 1, server.full)
+	assert.Equal(t, 1, server.notModified)
+
+	// Decoding the cached response into another type
+	var generic map[string]interface{}
+	require.NoError(t, GetRequest(context.Background(), &generic, ts.URL, common.ApiAllDeviceProfileRoute, nil, option))
+	assert.Equal(t, "1", generic["serviceName"])
+	assert.Equal(t, 2, server.notModified)
+
+	// A changed resource is transferred again
+	server.version = 2
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiAllDeviceProfileRoute, nil, option))
+	assert.Equal(t, "2", res.ServiceName)
+	assert.Equal(t, 2, server.full)
+	assert.Equal(t, `"`+common.ApiAllDeviceProfileRoute+`-1"|`, server.validators[len(server.validators)-1])
+
+	// Requests without the option are never conditional
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiAllDeviceProfileRoute, nil))
+	assert.Equal(t, "|", server.validators[len(server.validators)-1])
+	assert.Equal(t, 3, server.full)
+}
+
+func TestWithConditionalRequestsLastModified(t *testing.T) {
+	server := &conditionalServer{version: 1, lastModified: time.Now().Add(-time.Hour)}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	option := WithConditionalRequests(10)
+	var res dtoCommon.BaseWithServiceNameResponse
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiAllDeviceProfileRoute, nil, option))
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiAllDeviceProfileRoute, nil, option))
+	assert.Equal(t, "1", res.ServiceName)
+	assert.Equal(t, 1, server.full)
+	assert.Equal(t, 1, server.notModified)
+	assert.Equal(t, "|"+server.lastModified.UTC().Format(http.TimeFormat), server.validators[1])
+}
+
+func TestWithConditionalRequestsEviction(t *testing.T) {
+	server := &conditionalServer{version: 1, useETag: true}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	option := WithConditionalRequests(1)
+	var res dtoCommon.BaseWithServiceNameResponse
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiAllDeviceProfileRoute, nil, option))
+	// The cache holds a single URL, so reading another one evicts the first
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiAllDeviceRoute, nil, option))
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiAllDeviceProfileRoute, nil, option))
+	assert.Equal(t, common.ApiAllDeviceProfileRoute, res.Message)
+	assert.Equal(t, 3, server.full)
+	assert.Equal(t, 0, server.notModified)
+
+	// The query is part of the URL
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiAllDeviceProfileRoute, map[string][]string{common.Limit: {"-1"}}, option))
+	assert.Equal(t, 4, server.full)
+}
+
+func TestWithConditionalRequestsResponsesNotShared(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(common.ETag, `"1"`)
+		if r.Header.Get(common.IfNoneMatch) == `"1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_, _ = w.Write([]byte(`{"labels":["floor-1"],"tags":{"site":"A"}}`))
+	}))
+	defer ts.Close()
+
+	type response struct {
+		Labels []string          `json:"labels"`
+		Tags   map[string]string `json:"tags"`
+	}
+	option := WithConditionalRequests(10)
+	var first response
+	require.NoError(t, GetRequest(context.Background(), &first, ts.URL, common.ApiAllDeviceRoute, nil, option))
+	first.Labels[0] = "modified"
+	first.Tags["site"] = "modified"
+
+	for i := 0; i < 2; i++ {
+		var res response
+		require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiAllDeviceRoute, nil, option))
+		assert.Equal(t, response{Labels: []string{"floor-1"}, Tags: map[string]string{"site": "A"}}, res)
+		res.Labels[0] = "modified"
+		res.Tags["site"] = "modified"
+	}
+}
@@ -0,0 +1,197 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// WithRateLimit limits the requests sent to each base URL with a token bucket refilled with rate tokens per second and
+// holding at most burst tokens, burst being at least 1. A request waits for a token as long as its context allows and
+// fails with a KindLimitExceeded error if the wait would exceed the context deadline. A rate <= 0 never refills the
+// buckets, so that only burst requests are sent to each base URL and the next ones fail with a KindLimitExceeded error.
+// The buckets are shared by all the clients using the same option, e.g. a DeviceClient and a DeviceProfileClient
+// created with it share the limit of core-metadata.
+func WithRateLimit(rate float64, burst int) ClientOption {
+	limiter := &rateLimiter{rate: rate, burst: float64(burst), buckets: make(map[string]*tokenBucket)}
+	if limiter.burst < 1 {
+		limiter.burst = 1
+	}
+	return func(o *clientOptions) {
+		o.rateLimiter = limiter
+	}
+}
+
+// WithMaxConcurrency limits the number of concurrent requests to each base URL, a request holding its slot until its
+// response body is closed. A request waits for a slot as long as its context allows and fails with a KindLimitExceeded
+// error once the context is done.
+// The slots are shared by all the clients using the same option.
+func WithMaxConcurrency(max int) ClientOption {
+	limiter := &concurrencyLimiter{max: max, slots: make(map[string]chan struct{})}
+	if limiter.max < 1 {
+		limiter.max = 1
+	}
+	return func(o *clientOptions) {
+		o.concurrencyLimiter = limiter
+	}
+}
+
+// acquireLimits waits until the request to the URL is allowed by the rate and concurrency limits of its base URL.
+// The returned function releases the concurrency slot of the request and must be called once the request completed.
+func (o *clientOptions) acquireLimits(ctx context.Context, u *url.URL) (func(), errors.EdgeX) {
+	baseUrl := u.Scheme + "://" + u.Host
+	if o.rateLimiter != nil {
+		if err := o.rateLimiter.wait(ctx, baseUrl); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if o.concurrencyLimiter == nil {
+		return func() {}, nil
+	}
+	release, err := o.concurrencyLimiter.acquire(ctx, baseUrl)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return release, nil
+}
+
+type rateLimiter struct {
+	rate    float64
+	burst   float64
+	mutex   sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// wait takes a token from the bucket of the base URL, waiting for the bucket to be refilled if it is empty
+func (l *rateLimiter) wait(ctx context.Context, baseUrl string) errors.EdgeX {
+	l.mutex.Lock()
+	bucket, ok := l.buckets[baseUrl]
+	if !ok {
+		bucket = &tokenBucket{rate: l.rate, burst: l.burst, tokens: l.burst, last: time.Now()}
+		l.buckets[baseUrl] = bucket
+	}
+	l.mutex.Unlock()
+
+	deadline, hasDeadline := ctx.Deadline()
+	delay, ok := bucket.reserve(time.Now(), deadline, hasDeadline)
+	if !ok && l.rate <= 0 {
+		return errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("the rate limit of %s is exhausted as its %v requests were sent and it is not refilled", baseUrl, l.burst), nil)
+	} else if !ok {
+		return errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("the rate limit of %s would delay the request beyond its deadline", baseUrl), nil)
+	}
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// The request is given up, so its token can be used by another one
+		bucket.cancel()
+		return errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("the request was given up while waiting for the rate limit of %s", baseUrl), ctx.Err())
+	}
+}
+
+// tokenBucket holds up to burst tokens and is refilled with rate tokens per second. Its tokens become negative when
+// requests reserve tokens in advance, each of them waiting until its token is refilled.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// reserve takes a token and returns how long to wait until it is refilled. No token is taken and false is returned if
+// the wait would go past the deadline.
+func (b *tokenBucket) reserve(now time.Time, deadline time.Time, hasDeadline bool) (time.Duration, bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, true
+	}
+	if b.rate <= 0 {
+		return 0, false
+	}
+	delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+	if hasDeadline && now.Add(delay).After(deadline) {
+		return delay, false
+	}
+	b.tokens--
+	return delay, true
+}
+
+// cancel gives back a token taken by reserve
+func (b *tokenBucket) cancel() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.tokens++
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+type concurrencyLimiter struct {
+	max   int
+	mutex sync.Mutex
+	slots map[string]chan struct{}
+}
+
+// acquire waits for a free slot of the base URL and returns the function releasing it
+func (l *concurrencyLimiter) acquire(ctx context.Context, baseUrl string) (func(), errors.EdgeX) {
+	l.mutex.Lock()
+	slots, ok := l.slots[baseUrl]
+	if !ok {
+		slots = make(chan struct{}, l.max)
+		l.slots[baseUrl] = slots
+	}
+	l.mutex.Unlock()
+
+	select {
+	case slots <- struct{}{}:
+	default:
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("the request was given up while waiting for one of the %d concurrent requests to %s to complete", l.max, baseUrl), ctx.Err())
+		}
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() { <-slots })
+	}, nil
+}
+
+// releasingBody releases the concurrency slot of the request once the response body is closed
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
@@ -0,0 +1,203 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	now := time.Now()
+	bucket := &tokenBucket{rate: 10, burst: 2, tokens: 2, last: now}
+
+	// The burst is served immediately
+	delay, ok := bucket.reserve(now, time.Time{}, false)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), delay)
+	delay, ok = bucket.reserve(now, time.Time{}, false)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), delay)
+
+	// The next requests wait for their token to be refilled
+	delay, ok = bucket.reserve(now, time.Time{}, false)
+	assert.True(t, ok)
+	assert.Equal(t, 100*time.Millisecond, delay)
+	delay, ok = bucket.reserve(now, time.Time{}, false)
+	assert.True(t, ok)
+	assert.Equal(t, 200*time.Millisecond, delay)
+
+	// A wait beyond the deadline takes no token
+	delay, ok = bucket.reserve(now, now.Add(250*time.Millisecond), true)
+	assert.False(t, ok)
+	assert.Equal(t, 300*time.Millisecond, delay)
+	delay, ok = bucket.reserve(now, now.Add(300*time.Millisecond), true)
+	assert.True(t, ok)
+	assert.Equal(t, 300*time.Millisecond, delay)
+
+	// Giving up a reservation frees its token
+	bucket.cancel()
+	delay, ok = bucket.reserve(now.Add(time.Second), time.Time{}, false)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), delay)
+}
+
+func newCountingServer(count *int32, block chan struct{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(count, 1)
+		if block != nil {
+			<-block
+		}
+		_, _ = w.Write([]byte(`{"statusCode":200}`))
+	}))
+}
+
+func TestWithRateLimit(t *testing.T) {
+	var count, otherCount int32
+	ts := newCountingServer(&count, nil)
+	defer ts.Close()
+	other := newCountingServer(&otherCount, nil)
+	defer other.Close()
+
+	option := WithRateLimit(10, 1)
+	var res dtoCommon.BaseResponse
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiPingRoute, nil, option))
+
+	// The next token is refilled in 100ms, which is beyond the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := GetRequest(ctx, &res, ts.URL, common.ApiPingRoute, nil, option)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+	assert.Less(t, time.Since(start), 20*time.Millisecond, "the request should fail without waiting")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+
+	// Each base URL has its own bucket
+	require.NoError(t, GetRequest(ctx, &res, other.URL, common.ApiPingRoute, nil, option))
+
+	// Without deadline the request waits for the token
+	start = time.Now()
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiPingRoute, nil, option))
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&count))
+
+	// A cancelled wait fails as well
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	err = GetRequest(ctx, &res, ts.URL, common.ApiPingRoute, nil, option)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&count))
+}
+
+func TestWithRateLimitNotRefilled(t *testing.T) {
+	var count int32
+	ts := newCountingServer(&count, nil)
+	defer ts.Close()
+
+	option := WithRateLimit(0, 2)
+	var res dtoCommon.BaseResponse
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiPingRoute, nil, option))
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiPingRoute, nil, option))
+
+	// Without deadline, the request fails as the bucket is never refilled
+	err := GetRequest(context.Background(), &res, ts.URL, common.ApiPingRoute, nil, option)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+	assert.Contains(t, err.Error(), "is exhausted")
+	assert.NotContains(t, err.Error(), "deadline")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&count))
+}
+
+func TestWithMaxConcurrency(t *testing.T) {
+	var count, otherCount int32
+	block := make(chan struct{})
+	ts := newCountingServer(&count, block)
+	defer ts.Close()
+	other := newCountingServer(&otherCount, nil)
+	defer other.Close()
+
+	option := WithMaxConcurrency(1)
+	done := make(chan errors.EdgeX)
+	go func() {
+		var res dtoCommon.BaseResponse
+		done <- GetRequest(context.Background(), &res, ts.URL, common.ApiPingRoute, nil, option)
+	}()
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&count) == 1 }, time.Second, time.Millisecond)
+
+	// The only slot is held by the first request until its response completes
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	var res dtoCommon.BaseResponse
+	err := GetRequest(ctx, &res, ts.URL, common.ApiPingRoute, nil, option)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+
+	// Each base URL has its own slots
+	require.NoError(t, GetRequest(context.Background(), &res, other.URL, common.ApiPingRoute, nil, option))
+
+	close(block)
+	require.NoError(t, <-done)
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiPingRoute, nil, option))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&count))
+}
+
+func TestWithMaxConcurrencyReleasesFailedRequests(t *testing.T) {
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	option := WithMaxConcurrency(1)
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		var res dtoCommon.BaseResponse
+		err := GetRequest(ctx, &res, closed.URL, common.ApiPingRoute, nil, option)
+		cancel()
+		require.Error(t, err)
+		assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	}
+}
+
+func TestRateLimitWaitExcludedFromLatency(t *testing.T) {
+	var count int32
+	ts := newCountingServer(&count, nil)
+	defer ts.Close()
+
+	metrics := NewClientMetrics()
+	// The second request waits 200ms for its token
+	options := []ClientOption{WithRateLimit(5, 1), WithMetrics(metrics)}
+	var res dtoCommon.BaseResponse
+	start := time.Now()
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiPingRoute, nil, options...))
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiPingRoute, nil, options...))
+	require.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+
+	var maxLatency interface{}
+	for _, metric := range metrics.Metrics() {
+		if metric.Name != ClientRequestLatencyMetric {
+			continue
+		}
+		for _, field := range metric.Fields {
+			if field.Name == MetricFieldMax {
+				maxLatency = field.Value
+			}
+		}
+	}
+	require.NotNil(t, maxLatency)
+	assert.Less(t, maxLatency.(float64), float64(150), "the latency should not include the wait for the rate limit")
+}
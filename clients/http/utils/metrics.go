@@ -0,0 +1,332 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// Names of the metrics exported by ClientMetrics.Metrics
+const (
+	// ClientRequestLatencyMetric is the latency histogram of the requests, from sending the request to closing the response
+	// body, the time spent waiting for the rate and concurrency limits being left out
+	ClientRequestLatencyMetric = "ClientRequestLatency"
+	// ClientRequestStatusMetric counts the responses by status code
+	ClientRequestStatusMetric = "ClientRequestStatus"
+	// ClientRequestErrorMetric counts the failed requests by error kind
+	ClientRequestErrorMetric = "ClientRequestError"
+	// ClientRequestInFlightMetric is the number of requests waiting for their response to complete
+	ClientRequestInFlightMetric = "ClientRequestInFlight"
+	// ClientRequestBytesMetric is the number of bytes sent in the request bodies and received in the response bodies
+	ClientRequestBytesMetric = "ClientRequestBytes"
+)
+
+// Names of the tags and fields of the metrics exported by ClientMetrics.Metrics
+const (
+	MetricTagMethod     = "method"
+	MetricTagRoute      = "route"
+	MetricTagStatusCode = "statusCode"
+	MetricTagErrKind    = "errKind"
+
+	MetricFieldCount    = "count"
+	MetricFieldValue    = "value"
+	MetricFieldSum      = "sum"
+	MetricFieldMin      = "min"
+	MetricFieldMax      = "max"
+	MetricFieldMean     = "mean"
+	MetricFieldSent     = "sent"
+	MetricFieldReceived = "received"
+	// MetricFieldBucketPrefix prefixes the cumulative latency bucket fields, e.g. le_100ms counts the requests which
+	// took at most 100 milliseconds and le_inf counts all the requests
+	MetricFieldBucketPrefix = "le_"
+)
+
+// DefaultLatencyBuckets are the upper bounds of the latency histogram buckets used when none is given to NewClientMetrics
+var DefaultLatencyBuckets = []time.Duration{
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// ClientMetrics records the metrics of the requests sent by the clients, per method and route template.
+// It is safe for concurrent use and is usually shared by all the clients of a service through WithMetrics.
+type ClientMetrics struct {
+	buckets []time.Duration
+	mutex   sync.Mutex
+	routes  map[routeKey]*routeMetrics
+}
+
+type routeKey struct {
+	method string
+	route  string
+}
+
+type routeMetrics struct {
+	inFlight      int64
+	bucketCounts  []uint64
+	count         uint64
+	sum           time.Duration
+	min           time.Duration
+	max           time.Duration
+	statusCodes   map[int]uint64
+	errKinds      map[errors.ErrKind]uint64
+	bytesSent     int64
+	bytesReceived int64
+}
+
+// NewClientMetrics creates a ClientMetrics whose latency histograms use the given bucket upper bounds, or
+// DefaultLatencyBuckets if none is given
+func NewClientMetrics(buckets ...time.Duration) *ClientMetrics {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	sorted := make([]time.Duration, len(buckets))
+	copy(sorted, buckets)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return &ClientMetrics{
+		buckets: sorted,
+		routes:  make(map[routeKey]*routeMetrics),
+	}
+}
+
+// WithMetrics records the metrics of every request in the given ClientMetrics
+func WithMetrics(metrics *ClientMetrics) ClientOption {
+	return func(o *clientOptions) {
+		o.metrics = metrics
+	}
+}
+
+// routeMetrics returns the metrics of the route, the caller must hold the mutex
+func (m *ClientMetrics) routeMetrics(method string, route string) *routeMetrics {
+	key := routeKey{method: method, route: route}
+	metrics, ok := m.routes[key]
+	if !ok {
+		metrics = &routeMetrics{
+			bucketCounts: make([]uint64, len(m.buckets)),
+			statusCodes:  make(map[int]uint64),
+			errKinds:     make(map[errors.ErrKind]uint64),
+		}
+		m.routes[key] = metrics
+	}
+	return metrics
+}
+
+// startRequest records a request being sent with a body of bytesSent bytes and returns the recorder of its outcome.
+// A nil ClientMetrics returns a nil recorder, which records nothing.
+func (m *ClientMetrics) startRequest(method string, route string, bytesSent int64) *requestRecorder {
+	if m == nil {
+		return nil
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	metrics := m.routeMetrics(method, route)
+	metrics.inFlight++
+	if bytesSent > 0 {
+		metrics.bytesSent += bytesSent
+	}
+	return &requestRecorder{metrics: m, method: method, route: route, start: time.Now()}
+}
+
+// requestRecorder records the outcome of a request started by ClientMetrics.startRequest
+type requestRecorder struct {
+	metrics       *ClientMetrics
+	method        string
+	route         string
+	start         time.Time
+	bytesReceived int64
+	once          sync.Once
+}
+
+// send restarts the latency measure when the request is sent, once the request was allowed by the limits of its base URL
+func (r *requestRecorder) send() {
+	if r == nil {
+		return
+	}
+	r.start = time.Now()
+}
+
+// respond records the status code of the response, 0 if none was received, and the kind of error the request failed with
+func (r *requestRecorder) respond(statusCode int, errKind errors.ErrKind) {
+	if r == nil {
+		return
+	}
+	r.metrics.mutex.Lock()
+	defer r.metrics.mutex.Unlock()
+	metrics := r.metrics.routeMetrics(r.method, r.route)
+	if statusCode > 0 {
+		metrics.statusCodes[statusCode]++
+	}
+	if errKind != "" {
+		metrics.errKinds[errKind]++
+	}
+}
+
+// finish records the latency and the bytes received once the request completed, only the first call has an effect
+func (r *requestRecorder) finish() {
+	if r == nil {
+		return
+	}
+	r.once.Do(func() {
+		latency := time.Since(r.start)
+		r.metrics.mutex.Lock()
+		defer r.metrics.mutex.Unlock()
+		metrics := r.metrics.routeMetrics(r.method, r.route)
+		metrics.inFlight--
+		metrics.bytesReceived += atomic.LoadInt64(&r.bytesReceived)
+		for i, bound := range r.metrics.buckets {
+			if latency <= bound {
+				metrics.bucketCounts[i]++
+			}
+		}
+		if metrics.count == 0 || latency < metrics.min {
+			metrics.min = latency
+		}
+		if latency > metrics.max {
+			metrics.max = latency
+		}
+		metrics.count++
+		metrics.sum += latency
+	})
+}
+
+// wrapBody counts the bytes read from the response body and finishes the request when the body is closed
+func (r *requestRecorder) wrapBody(body io.ReadCloser) io.ReadCloser {
+	if r == nil {
+		return body
+	}
+	return &recordedBody{ReadCloser: body, recorder: r}
+}
+
+type recordedBody struct {
+	io.ReadCloser
+	recorder *requestRecorder
+}
+
+func (b *recordedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	atomic.AddInt64(&b.recorder.bytesReceived, int64(n))
+	return n, err
+}
+
+func (b *recordedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.recorder.finish()
+	return err
+}
+
+// Metrics exports the metrics recorded so far as dtos.Metric values tagged with the method and route template, so that
+// they can be published through the EdgeX metrics pipeline
+func (m *ClientMetrics) Metrics() []dtos.Metric {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	keys := make([]routeKey, 0, len(m.routes))
+	for key := range m.routes {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].route != keys[j].route {
+			return keys[i].route < keys[j].route
+		}
+		return keys[i].method < keys[j].method
+	})
+
+	timestamp := time.Now().UnixNano()
+	var result []dtos.Metric
+	for _, key := range keys {
+		metrics := m.routes[key]
+		tags := []dtos.MetricTag{{Name: MetricTagMethod, Value: key.method}, {Name: MetricTagRoute, Value: key.route}}
+
+		result = append(result, newClientMetric(ClientRequestLatencyMetric, m.latencyFields(metrics), tags, timestamp))
+
+		statusCodes := make([]int, 0, len(metrics.statusCodes))
+		for statusCode := range metrics.statusCodes {
+			statusCodes = append(statusCodes, statusCode)
+		}
+		sort.Ints(statusCodes)
+		for _, statusCode := range statusCodes {
+			statusTags := append(tags[:len(tags):len(tags)], dtos.MetricTag{Name: MetricTagStatusCode, Value: strconv.Itoa(statusCode)})
+			fields := []dtos.MetricField{{Name: MetricFieldCount, Value: metrics.statusCodes[statusCode]}}
+			result = append(result, newClientMetric(ClientRequestStatusMetric, fields, statusTags, timestamp))
+		}
+
+		errKinds := make([]string, 0, len(metrics.errKinds))
+		for errKind := range metrics.errKinds {
+			errKinds = append(errKinds, string(errKind))
+		}
+		sort.Strings(errKinds)
+		for _, errKind := range errKinds {
+			errKindTags := append(tags[:len(tags):len(tags)], dtos.MetricTag{Name: MetricTagErrKind, Value: errKind})
+			fields := []dtos.MetricField{{Name: MetricFieldCount, Value: metrics.errKinds[errors.ErrKind(errKind)]}}
+			result = append(result, newClientMetric(ClientRequestErrorMetric, fields, errKindTags, timestamp))
+		}
+
+		result = append(result, newClientMetric(ClientRequestInFlightMetric, []dtos.MetricField{{Name: MetricFieldValue, Value: metrics.inFlight}}, tags, timestamp))
+		result = append(result, newClientMetric(ClientRequestBytesMetric, []dtos.MetricField{
+			{Name: MetricFieldSent, Value: metrics.bytesSent},
+			{Name: MetricFieldReceived, Value: metrics.bytesReceived},
+		}, tags, timestamp))
+	}
+	return result
+}
+
+// latencyFields returns the fields of the latency histogram, the durations being expressed in milliseconds
+func (m *ClientMetrics) latencyFields(metrics *routeMetrics) []dtos.MetricField {
+	mean := 0.0
+	if metrics.count > 0 {
+		mean = durationToMilliseconds(metrics.sum) / float64(metrics.count)
+	}
+	fields := []dtos.MetricField{
+		{Name: MetricFieldCount, Value: metrics.count},
+		{Name: MetricFieldSum, Value: durationToMilliseconds(metrics.sum)},
+		{Name: MetricFieldMin, Value: durationToMilliseconds(metrics.min)},
+		{Name: MetricFieldMax, Value: durationToMilliseconds(metrics.max)},
+		{Name: MetricFieldMean, Value: mean},
+	}
+	for i, bound := range m.buckets {
+		fields = append(fields, dtos.MetricField{Name: bucketFieldName(bound), Value: metrics.bucketCounts[i]})
+	}
+	return append(fields, dtos.MetricField{Name: MetricFieldBucketPrefix + "inf", Value: metrics.count})
+}
+
+// bucketFieldName names the bucket field after its upper bound in milliseconds, e.g. le_2.5ms
+func bucketFieldName(bound time.Duration) string {
+	return fmt.Sprintf("%s%sms", MetricFieldBucketPrefix, strconv.FormatFloat(float64(bound)/float64(time.Millisecond), 'f', -1, 64))
+}
+
+// durationToMilliseconds converts the duration to milliseconds with a microsecond precision
+func durationToMilliseconds(d time.Duration) float64 {
+	return math.Round(float64(d)/float64(time.Microsecond)) / 1000
+}
+
+func newClientMetric(name string, fields []dtos.MetricField, tags []dtos.MetricTag, timestamp int64) dtos.Metric {
+	return dtos.Metric{
+		Versionable: dtoCommon.NewVersionable(),
+		Name:        name,
+		Fields:      fields,
+		Tags:        tags,
+		Timestamp:   timestamp,
+	}
+}
@@ -0,0 +1,139 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// metricFields returns the fields of the exported metric with the given name and tags, nil if there is none
+func metricFields(metrics []dtos.Metric, name string, tags map[string]string) map[string]interface{} {
+	for _, metric := range metrics {
+		if metric.Name != name || len(metric.Tags) != len(tags) {
+			continue
+		}
+		matched := true
+		for _, tag := range metric.Tags {
+			if tags[tag.Name] != tag.Value {
+				matched = false
+			}
+		}
+		if !matched {
+			continue
+		}
+		fields := make(map[string]interface{})
+		for _, field := range metric.Fields {
+			fields[field.Name] = field.Value
+		}
+		return fields
+	}
+	return nil
+}
+
+func TestWithMetrics(t *testing.T) {
+	const responseBody = `{"apiVersion":"v2","statusCode":200}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == common.ApiDeviceRoute+"/name/unknown" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(responseBody))
+	}))
+	defer ts.Close()
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	metrics := NewClientMetrics(time.Nanosecond, time.Minute)
+	option := WithMetrics(metrics)
+	var res dtoCommon.BaseResponse
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiDeviceRoute+"/name/device1", nil, option))
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, common.ApiDeviceRoute+"/name/device2", nil, option))
+	err := GetRequest(context.Background(), &res, ts.URL, common.ApiDeviceRoute+"/name/unknown", nil, option)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	err = GetRequest(context.Background(), &res, closed.URL, common.ApiDeviceRoute+"/name/device1", nil, option)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	data := []string{"device"}
+	require.NoError(t, PostRequestWithRawData(context.Background(), &res, ts.URL, common.ApiDeviceRoute, nil, data, option))
+	encoded, _ := json.Marshal(data)
+
+	exported := metrics.Metrics()
+	getTags := map[string]string{MetricTagMethod: http.MethodGet, MetricTagRoute: common.ApiDeviceByNameRoute}
+	postTags := map[string]string{MetricTagMethod: http.MethodPost, MetricTagRoute: common.ApiDeviceRoute}
+	withTag := func(tags map[string]string, name string, value string) map[string]string {
+		result := map[string]string{name: value}
+		for k, v := range tags {
+			result[k] = v
+		}
+		return result
+	}
+
+	latency := metricFields(exported, ClientRequestLatencyMetric, getTags)
+	require.NotNil(t, latency)
+	assert.Equal(t, uint64(4), latency[MetricFieldCount])
+	assert.Equal(t, uint64(0), latency["le_0.000001ms"])
+	assert.Equal(t, uint64(4), latency["le_60000ms"])
+	assert.Equal(t, uint64(4), latency["le_inf"])
+	assert.Greater(t, latency[MetricFieldSum], 0.0)
+	assert.LessOrEqual(t, latency[MetricFieldMin], latency[MetricFieldMean])
+	assert.LessOrEqual(t, latency[MetricFieldMean], latency[MetricFieldMax])
+
+	assert.Equal(t, map[string]interface{}{MetricFieldCount: uint64(2)}, metricFields(exported, ClientRequestStatusMetric, withTag(getTags, MetricTagStatusCode, "200")))
+	assert.Equal(t, map[string]interface{}{MetricFieldCount: uint64(1)}, metricFields(exported, ClientRequestStatusMetric, withTag(getTags, MetricTagStatusCode, "404")))
+	assert.Equal(t, map[string]interface{}{MetricFieldCount: uint64(1)}, metricFields(exported, ClientRequestErrorMetric, withTag(getTags, MetricTagErrKind, string(errors.KindEntityDoesNotExist))))
+	assert.Equal(t, map[string]interface{}{MetricFieldCount: uint64(1)}, metricFields(exported, ClientRequestErrorMetric, withTag(getTags, MetricTagErrKind, string(errors.KindServiceUnavailable))))
+	assert.Equal(t, map[string]interface{}{MetricFieldValue: int64(0)}, metricFields(exported, ClientRequestInFlightMetric, getTags))
+	assert.Equal(t, map[string]interface{}{MetricFieldSent: int64(0), MetricFieldReceived: int64(2 * len(responseBody))}, metricFields(exported, ClientRequestBytesMetric, getTags))
+
+	assert.Equal(t, map[string]interface{}{MetricFieldCount: uint64(1)}, metricFields(exported, ClientRequestStatusMetric, withTag(postTags, MetricTagStatusCode, "200")))
+	assert.Equal(t, map[string]interface{}{MetricFieldSent: int64(len(encoded)), MetricFieldReceived: int64(len(responseBody))}, metricFields(exported, ClientRequestBytesMetric, postTags))
+	assert.Nil(t, metricFields(exported, ClientRequestErrorMetric, withTag(postTags, MetricTagErrKind, string(errors.KindServiceUnavailable))))
+
+	for _, metric := range exported {
+		_, err := dtos.NewMetric(metric.Name, metric.Fields, metric.Tags)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, metric.ToLineProtocol())
+	}
+}
+
+func TestWithMetricsInFlight(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"readings":[{"id":"1"},{"id":"2"}]}`))
+	}))
+	defer ts.Close()
+
+	metrics := NewClientMetrics()
+	tags := map[string]string{MetricTagMethod: http.MethodGet, MetricTagRoute: common.ApiAllReadingRoute}
+	var res dtoCommon.BaseWithTotalCountResponse
+	var inFlight []interface{}
+	err := GetRequestStream(context.Background(), &res, ts.URL, common.ApiAllReadingRoute, nil, "readings",
+		func() interface{} { return &dtos.BaseReading{} },
+		func(element interface{}) errors.EdgeX {
+			// The request is still in flight while its response body is being read
+			inFlight = append(inFlight, metricFields(metrics.Metrics(), ClientRequestInFlightMetric, tags)[MetricFieldValue])
+			return nil
+		}, WithMetrics(metrics))
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(1), int64(1)}, inFlight)
+	assert.Equal(t, int64(0), metricFields(metrics.Metrics(), ClientRequestInFlightMetric, tags)[MetricFieldValue])
+}
+
+func TestBucketFieldName(t *testing.T) {
+	assert.Equal(t, "le_2.5ms", bucketFieldName(2500*time.Microsecond))
+	assert.Equal(t, "le_1000ms", bucketFieldName(time.Second))
+}
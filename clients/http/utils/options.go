@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// ClientOption customizes how the request helpers in this package send requests and read responses.
+// Options are usually supplied once to a NewXxxClient constructor, which passes them to every request it makes.
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	// maxResponseSize is the maximum number of bytes read from a response body, a value <= 0 means no limit.
+	maxResponseSize int64
+	// compression is the content encoding used to compress request bodies, empty means no compression.
+	compression string
+	// compressionThreshold is the minimum size in bytes of a request body to be compressed.
+	compressionThreshold int
+	// tlsTransport provides the transport configured by WithTLSConfig, nil means the default transport.
+	tlsTransport *tlsTransport
+	// resolver resolves the base URL of every request, nil means the base URL given to the client is used.
+	resolver interfaces.EndpointResolver
+	// spanHooks are invoked around every request, see WithSpanHooks.
+	spanHooks SpanHooks
+	// metrics records the metrics of every request, nil means no metrics are recorded.
+	metrics *ClientMetrics
+	// rateLimiter limits the rate of the requests to each base URL, nil means no limit.
+	rateLimiter *rateLimiter
+	// concurrencyLimiter limits the number of concurrent requests to each base URL, nil means no limit.
+	concurrencyLimiter *concurrencyLimiter
+	// conditionalCache holds the responses revalidated by the conditional GET requests, nil means no conditional requests.
+	conditionalCache *conditionalCache
+}
+
+func newClientOptions(opts []ClientOption) *clientOptions {
+	o := &clientOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+	return o
+}
+
+// httpClient returns the http.Client which sends the request according to the options
+func (o *clientOptions) httpClient(req *http.Request) (*http.Client, errors.EdgeX) {
+	client := &http.Client{}
+	_, unix := unixSocketPath(req.URL.Host)
+	if o.tlsTransport != nil {
+		transport, err := o.tlsTransport.get(unix)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		client.Transport = transport
+	} else if unix {
+		client.Transport = unixTransport()
+	}
+	if unix && (req.Host == "" || req.Host == req.URL.Host) {
+		req.Host = unixHostHeader
+	}
+	return client, nil
+}
+
+// WithMaxResponseSize limits the number of bytes read from a response body. Reading a response larger than the limit
+// fails with a KindLimitExceeded error instead of buffering the whole body. A size <= 0 means no limit, which is the
+// default.
+func WithMaxResponseSize(size int64) ClientOption {
+	return func(o *clientOptions) {
+		o.maxResponseSize = size
+	}
+}
+
+// WithCompression compresses request bodies of at least threshold bytes with the given content encoding, which is
+// either common.ContentEncodingGzip or common.ContentEncodingDeflate, and sets the Content-Encoding header accordingly.
+// It also advertises both encodings through the Accept-Encoding header and transparently decodes compressed responses.
+func WithCompression(encoding string, threshold int) ClientOption {
+	return func(o *clientOptions) {
+		o.compression = encoding
+		o.compressionThreshold = threshold
+	}
+}
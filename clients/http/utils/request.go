@@ -3,6 +3,11 @@
 //
 // SPDX-License-Identifier: Apache-2.0
 
+// Package utils provides the helpers sending the REST requests of the EdgeX clients and the options customizing them.
+//
+// The request paths given to the helpers are escaped paths, their segments being escaped like routes.Build does, so a
+// name holding a percent sign or a slash must be escaped by the caller, e.g. with url.PathEscape. A request path which
+// is not a valid escaped path, e.g. holding a percent sign not followed by two hexadecimal digits, is sent as is.
 package utils
 
 import (
@@ -17,13 +22,17 @@ import (
 )
 
 // GetRequest makes the get request and return the body
-func GetRequest(ctx context.Context, returnValuePointer interface{}, baseUrl string, requestPath string, requestParams url.Values) errors.EdgeX {
-	req, err := createRequest(ctx, http.MethodGet, baseUrl, requestPath, requestParams)
+func GetRequest(ctx context.Context, returnValuePointer interface{}, baseUrl string, requestPath string, requestParams url.Values, opts ...ClientOption) errors.EdgeX {
+	o := newClientOptions(opts)
+	req, err := createRequest(ctx, http.MethodGet, baseUrl, requestPath, requestParams, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	if o.conditionalCache != nil {
+		return sendConditionalRequest(ctx, returnValuePointer, req, o)
+	}
 
-	res, err := sendRequest(ctx, req)
+	res, err := sendRequest(ctx, req, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -38,19 +47,20 @@ func GetRequest(ctx context.Context, returnValuePointer interface{}, baseUrl str
 }
 
 // GetRequestAndReturnBinaryRes makes the get request and return the binary response and content type(i.e., application/json, application/cbor, ... )
-func GetRequestAndReturnBinaryRes(ctx context.Context, baseUrl string, requestPath string, requestParams url.Values) (res []byte, contentType string, edgeXerr errors.EdgeX) {
-	req, edgeXerr := createRequest(ctx, http.MethodGet, baseUrl, requestPath, requestParams)
+func GetRequestAndReturnBinaryRes(ctx context.Context, baseUrl string, requestPath string, requestParams url.Values, opts ...ClientOption) (res []byte, contentType string, edgeXerr errors.EdgeX) {
+	o := newClientOptions(opts)
+	req, edgeXerr := createRequest(ctx, http.MethodGet, baseUrl, requestPath, requestParams, o)
 	if edgeXerr != nil {
 		return nil, "", errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
 
-	resp, edgeXerr := makeRequest(req)
+	resp, edgeXerr := makeRequest(ctx, req, o)
 	if edgeXerr != nil {
 		return nil, "", errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
 	defer resp.Body.Close()
 
-	res, edgeXerr = getBody(resp)
+	res, edgeXerr = getBody(resp, o)
 	if edgeXerr != nil {
 		return nil, "", errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
@@ -68,13 +78,14 @@ func GetRequestAndReturnBinaryRes(ctx context.Context, baseUrl string, requestPa
 }
 
 // GetRequestWithBodyRawData makes the GET request with JSON raw data as request body and return the response
-func GetRequestWithBodyRawData(ctx context.Context, returnValuePointer interface{}, baseUrl string, requestPath string, requestParams url.Values, data interface{}) errors.EdgeX {
-	req, err := createRequestWithRawDataAndParams(ctx, http.MethodGet, baseUrl, requestPath, requestParams, data)
+func GetRequestWithBodyRawData(ctx context.Context, returnValuePointer interface{}, baseUrl string, requestPath string, requestParams url.Values, data interface{}, opts ...ClientOption) errors.EdgeX {
+	o := newClientOptions(opts)
+	req, err := createRequestWithRawDataAndParams(ctx, http.MethodGet, baseUrl, requestPath, requestParams, data, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	res, err := sendRequest(ctx, req)
+	res, err := sendRequest(ctx, req, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -90,14 +101,16 @@ func PostRequest(
 	returnValuePointer interface{},
 	baseUrl string, requestPath string,
 	data []byte,
-	encoding string) errors.EdgeX {
+	encoding string,
+	opts ...ClientOption) errors.EdgeX {
 
-	req, err := createRequestWithEncodedData(ctx, http.MethodPost, baseUrl, requestPath, data, encoding)
+	o := newClientOptions(opts)
+	req, err := createRequestWithEncodedData(ctx, http.MethodPost, baseUrl, requestPath, data, encoding, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	res, err := sendRequest(ctx, req)
+	res, err := sendRequest(ctx, req, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -113,14 +126,16 @@ func PostRequestWithRawData(
 	returnValuePointer interface{},
 	baseUrl string, requestPath string,
 	requestParams url.Values,
-	data interface{}) errors.EdgeX {
+	data interface{},
+	opts ...ClientOption) errors.EdgeX {
 
-	req, err := createRequestWithRawData(ctx, http.MethodPost, baseUrl, requestPath, requestParams, data)
+	o := newClientOptions(opts)
+	req, err := createRequestWithRawData(ctx, http.MethodPost, baseUrl, requestPath, requestParams, data, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	res, err := sendRequest(ctx, req)
+	res, err := sendRequest(ctx, req, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -136,14 +151,16 @@ func PutRequest(
 	returnValuePointer interface{},
 	baseUrl string, requestPath string,
 	requestParams url.Values,
-	data interface{}) errors.EdgeX {
+	data interface{},
+	opts ...ClientOption) errors.EdgeX {
 
-	req, err := createRequestWithRawData(ctx, http.MethodPut, baseUrl, requestPath, requestParams, data)
+	o := newClientOptions(opts)
+	req, err := createRequestWithRawData(ctx, http.MethodPut, baseUrl, requestPath, requestParams, data, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	res, err := sendRequest(ctx, req)
+	res, err := sendRequest(ctx, req, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -159,14 +176,16 @@ func PatchRequest(
 	returnValuePointer interface{},
 	baseUrl string, requestPath string,
 	requestParams url.Values,
-	data interface{}) errors.EdgeX {
+	data interface{},
+	opts ...ClientOption) errors.EdgeX {
 
-	req, err := createRequestWithRawData(ctx, http.MethodPatch, baseUrl, requestPath, requestParams, data)
+	o := newClientOptions(opts)
+	req, err := createRequestWithRawData(ctx, http.MethodPatch, baseUrl, requestPath, requestParams, data, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	res, err := sendRequest(ctx, req)
+	res, err := sendRequest(ctx, req, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -181,14 +200,16 @@ func PostByFileRequest(
 	ctx context.Context,
 	returnValuePointer interface{},
 	baseUrl string, requestPath string,
-	filePath string) errors.EdgeX {
+	filePath string,
+	opts ...ClientOption) errors.EdgeX {
 
-	req, err := createRequestFromFilePath(ctx, http.MethodPost, baseUrl, requestPath, filePath)
+	o := newClientOptions(opts)
+	req, err := createRequestFromFilePath(ctx, http.MethodPost, baseUrl, requestPath, filePath, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	res, err := sendRequest(ctx, req)
+	res, err := sendRequest(ctx, req, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -203,14 +224,16 @@ func PutByFileRequest(
 	ctx context.Context,
 	returnValuePointer interface{},
 	baseUrl string, requestPath string,
-	filePath string) errors.EdgeX {
+	filePath string,
+	opts ...ClientOption) errors.EdgeX {
 
-	req, err := createRequestFromFilePath(ctx, http.MethodPut, baseUrl, requestPath, filePath)
+	o := newClientOptions(opts)
+	req, err := createRequestFromFilePath(ctx, http.MethodPut, baseUrl, requestPath, filePath, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	res, err := sendRequest(ctx, req)
+	res, err := sendRequest(ctx, req, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -221,13 +244,14 @@ func PutByFileRequest(
 }
 
 // DeleteRequest makes the delete request and return the body
-func DeleteRequest(ctx context.Context, returnValuePointer interface{}, baseUrl string, requestPath string) errors.EdgeX {
-	req, err := createRequest(ctx, http.MethodDelete, baseUrl, requestPath, nil)
+func DeleteRequest(ctx context.Context, returnValuePointer interface{}, baseUrl string, requestPath string, opts ...ClientOption) errors.EdgeX {
+	o := newClientOptions(opts)
+	req, err := createRequest(ctx, http.MethodDelete, baseUrl, requestPath, nil, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	res, err := sendRequest(ctx, req)
+	res, err := sendRequest(ctx, req, o)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
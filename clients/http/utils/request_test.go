@@ -0,0 +1,73 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+)
+
+func TestRequestAbortedByCancelledContext(t *testing.T) {
+	aborted := make(chan struct{}, 2)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The server only notices the closed connection once the body is read
+		_, _ = io.Copy(io.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+			aborted <- struct{}{}
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	tests := []struct {
+		name    string
+		request func(ctx context.Context) error
+	}{
+		{"without body", func(ctx context.Context) error {
+			var res dtoCommon.BaseResponse
+			return GetRequest(ctx, &res, server.URL, common.ApiVersionRoute, nil)
+		}},
+		{"with body", func(ctx context.Context) error {
+			var res dtoCommon.BaseResponse
+			return PostRequest(ctx, &res, server.URL, common.ApiVersionRoute, []byte("{}"), common.ContentTypeJSON)
+		}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(50*time.Millisecond, cancel)
+
+			done := make(chan error, 1)
+			go func() {
+				done <- testCase.request(ctx)
+			}()
+			select {
+			case err := <-done:
+				require.Error(t, err)
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "the request was not aborted by the cancelled context")
+			}
+			select {
+			case <-aborted:
+			case <-time.After(5 * time.Second):
+				assert.Fail(t, "the server did not see the request aborted")
+			}
+		})
+	}
+}
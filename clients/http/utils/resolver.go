@@ -0,0 +1,235 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// WithEndpointResolver makes every request consult the resolver for the base URL of the service instead of using the
+// base URL given to the client. The resolved base URL is cached for the refresh interval, which defaults to
+// common.ClientMonitorDefault milliseconds when it is <= 0. If the resolver fails, the last base URL successfully
+// resolved is used, or the base URL given to the client if the resolver never succeeded.
+// The resolver stands for the single service targeted by a client, so it is ignored by the clients which are given
+// the base URL of each request, e.g. DeviceServiceCommandClient, see WithoutEndpointResolver.
+func WithEndpointResolver(resolver interfaces.EndpointResolver, refreshInterval time.Duration) ClientOption {
+	// The cache is shared by all requests using this option
+	cached := NewCachedEndpointResolver(resolver, refreshInterval)
+	return func(o *clientOptions) {
+		o.resolver = cached
+	}
+}
+
+// WithoutEndpointResolver cancels the WithEndpointResolver options preceding it, so that the requests are sent to the
+// base URL they are given
+func WithoutEndpointResolver() ClientOption {
+	return func(o *clientOptions) {
+		o.resolver = nil
+	}
+}
+
+// resolveBaseUrl returns the base URL of the request, resolved by the endpoint resolver if one is configured
+func (o *clientOptions) resolveBaseUrl(ctx context.Context, baseUrl string) (string, errors.EdgeX) {
+	if o.resolver == nil {
+		return baseUrl, nil
+	}
+	resolved, err := o.resolver.Resolve(ctx)
+	if err != nil {
+		if baseUrl != "" {
+			return baseUrl, nil
+		}
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	return resolved, nil
+}
+
+type staticEndpointResolver struct {
+	baseUrl string
+}
+
+// NewStaticEndpointResolver creates an EndpointResolver which always resolves to the given base URL
+func NewStaticEndpointResolver(baseUrl string) interfaces.EndpointResolver {
+	return &staticEndpointResolver{baseUrl: baseUrl}
+}
+
+func (r *staticEndpointResolver) Resolve(_ context.Context) (string, errors.EdgeX) {
+	return r.baseUrl, nil
+}
+
+type fileEndpointResolver struct {
+	filePath string
+}
+
+// NewFileEndpointResolver creates an EndpointResolver which reads the base URL from a file, so that it can be updated
+// by rewriting the file. Leading and trailing white spaces are ignored.
+func NewFileEndpointResolver(filePath string) interfaces.EndpointResolver {
+	return &fileEndpointResolver{filePath: filePath}
+}
+
+func (r *fileEndpointResolver) Resolve(_ context.Context) (string, errors.EdgeX) {
+	contents, err := os.ReadFile(r.filePath)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("failed to read the base URL from %s", r.filePath), err)
+	}
+	baseUrl := strings.TrimSpace(string(contents))
+	if baseUrl == "" {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no base URL found in %s", r.filePath), nil)
+	}
+	return baseUrl, nil
+}
+
+type dnsSRVEndpointResolver struct {
+	scheme    string
+	service   string
+	proto     string
+	name      string
+	lookupSRV func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// NewDNSSRVEndpointResolver creates an EndpointResolver which looks up the DNS SRV records of _service._proto.name, e.g.
+// _core-data._tcp.edgex.local, and resolves to scheme://target:port of the record with the highest priority.
+// When several records share the highest priority, one of them is picked according to their weights.
+func NewDNSSRVEndpointResolver(scheme string, service string, proto string, name string) interfaces.EndpointResolver {
+	return &dnsSRVEndpointResolver{
+		scheme:    scheme,
+		service:   service,
+		proto:     proto,
+		name:      name,
+		lookupSRV: net.DefaultResolver.LookupSRV,
+	}
+}
+
+func (r *dnsSRVEndpointResolver) Resolve(ctx context.Context) (string, errors.EdgeX) {
+	// The records returned by LookupSRV are sorted by priority and randomized by weight
+	_, records, err := r.lookupSRV(ctx, r.service, r.proto, r.name)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("failed to look up the SRV records of %s", r.name), err)
+	}
+	if len(records) == 0 {
+		return "", errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no SRV record found for %s", r.name), nil)
+	}
+	host := strings.TrimSuffix(records[0].Target, ".")
+	return fmt.Sprintf("%s://%s", r.scheme, net.JoinHostPort(host, strconv.Itoa(int(records[0].Port)))), nil
+}
+
+type callbackEndpointResolver struct {
+	callback func(ctx context.Context) (string, errors.EdgeX)
+}
+
+// NewCallbackEndpointResolver creates an EndpointResolver which delegates the resolution to the callback, e.g. to query
+// the service registry
+func NewCallbackEndpointResolver(callback func(ctx context.Context) (string, errors.EdgeX)) interfaces.EndpointResolver {
+	return &callbackEndpointResolver{callback: callback}
+}
+
+func (r *callbackEndpointResolver) Resolve(ctx context.Context) (string, errors.EdgeX) {
+	baseUrl, err := r.callback(ctx)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	return baseUrl, nil
+}
+
+type cachedEndpointResolver struct {
+	resolver        interfaces.EndpointResolver
+	refreshInterval time.Duration
+	mutex           sync.Mutex
+	baseUrl         string
+	resolvedAt      time.Time
+	// refresh is the refresh in progress, nil if there is none
+	refresh *endpointRefresh
+}
+
+// endpointRefresh is the result of a refresh, available once done is closed
+type endpointRefresh struct {
+	done    chan struct{}
+	baseUrl string
+	err     errors.EdgeX
+}
+
+// NewCachedEndpointResolver creates an EndpointResolver which caches the base URL resolved by the given resolver for the
+// refresh interval, which defaults to common.ClientMonitorDefault milliseconds when it is <= 0.
+// When a refresh fails, the last base URL successfully resolved keeps being returned until the next refresh.
+// A single refresh runs at a time: the callers wait for it while no base URL was resolved yet, and otherwise keep
+// getting the last base URL resolved until it completes. The refresh is not cancelled with the context of the caller
+// which started it, as the other callers wait for it, but it is given up after the refresh interval.
+func NewCachedEndpointResolver(resolver interfaces.EndpointResolver, refreshInterval time.Duration) interfaces.EndpointResolver {
+	if refreshInterval <= 0 {
+		refreshInterval = common.ClientMonitorDefault * time.Millisecond
+	}
+	return &cachedEndpointResolver{
+		resolver:        resolver,
+		refreshInterval: refreshInterval,
+	}
+}
+
+func (r *cachedEndpointResolver) Resolve(ctx context.Context) (string, errors.EdgeX) {
+	r.mutex.Lock()
+	if r.baseUrl != "" && (r.refresh != nil || time.Since(r.resolvedAt) < r.refreshInterval) {
+		baseUrl := r.baseUrl
+		r.mutex.Unlock()
+		return baseUrl, nil
+	}
+	refresh := r.refresh
+	if refresh == nil {
+		refresh = &endpointRefresh{done: make(chan struct{})}
+		r.refresh = refresh
+		go r.run(detachedContext{ctx}, refresh)
+	}
+	r.mutex.Unlock()
+
+	select {
+	case <-refresh.done:
+		return refresh.baseUrl, refresh.err
+	case <-ctx.Done():
+		return "", errors.NewCommonEdgeX(errors.KindServiceUnavailable, "the request was given up while resolving the base URL", ctx.Err())
+	}
+}
+
+// run resolves the base URL and publishes the result to the callers waiting for the refresh
+func (r *cachedEndpointResolver) run(ctx context.Context, refresh *endpointRefresh) {
+	ctx, cancel := context.WithTimeout(ctx, r.refreshInterval)
+	defer cancel()
+	baseUrl, err := r.resolver.Resolve(ctx)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if err == nil {
+		r.baseUrl = baseUrl
+	}
+	if r.baseUrl != "" {
+		// On failure, fall back to the last known good base URL and try again at the next refresh
+		r.resolvedAt = time.Now()
+		refresh.baseUrl = r.baseUrl
+	} else {
+		refresh.err = errors.NewCommonEdgeXWrapper(err)
+	}
+	r.refresh = nil
+	close(refresh.done)
+}
+
+// detachedContext keeps the values of its parent context, e.g. the tracing spans, but not its deadline and cancellation
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
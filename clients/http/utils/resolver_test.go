@@ -0,0 +1,277 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+const testBaseUrl = "http://localhost:59880"
+
+func TestStaticEndpointResolver(t *testing.T) {
+	baseUrl, err := NewStaticEndpointResolver(testBaseUrl).Resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, testBaseUrl, baseUrl)
+}
+
+func TestFileEndpointResolver(t *testing.T) {
+	dir := t.TempDir()
+	validFile := filepath.Join(dir, "core-data")
+	emptyFile := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(validFile, []byte(" "+testBaseUrl+"\n"), 0600))
+	require.NoError(t, os.WriteFile(emptyFile, []byte("\n"), 0600))
+
+	tests := []struct {
+		name            string
+		filePath        string
+		expectedErrKind errors.ErrKind
+	}{
+		{"valid", validFile, ""},
+		{"invalid - file not found", filepath.Join(dir, "missing"), errors.KindIOError},
+		{"invalid - empty file", emptyFile, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			baseUrl, err := NewFileEndpointResolver(testCase.filePath).Resolve(context.Background())
+			if testCase.expectedErrKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testBaseUrl, baseUrl)
+		})
+	}
+}
+
+func TestDNSSRVEndpointResolver(t *testing.T) {
+	tests := []struct {
+		name            string
+		records         []*net.SRV
+		lookupErr       error
+		expectedBaseUrl string
+		expectedErrKind errors.ErrKind
+	}{
+		{"valid", []*net.SRV{{Target: "core-data.edgex.local.", Port: 59880}, {Target: "backup.edgex.local.", Port: 59881}}, nil, "https://core-data.edgex.local:59880", ""},
+		{"invalid - no record", nil, nil, "", errors.KindEntityDoesNotExist},
+		{"invalid - lookup failure", nil, &net.DNSError{Err: "no such host", Name: "edgex.local"}, "", errors.KindServiceUnavailable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := NewDNSSRVEndpointResolver("https", common.CoreDataServiceKey, "tcp", "edgex.local").(*dnsSRVEndpointResolver)
+			resolver.lookupSRV = func(_ context.Context, service, proto, name string) (string, []*net.SRV, error) {
+				assert.Equal(t, common.CoreDataServiceKey, service)
+				assert.Equal(t, "tcp", proto)
+				assert.Equal(t, "edgex.local", name)
+				return "", testCase.records, testCase.lookupErr
+			}
+			baseUrl, err := resolver.Resolve(context.Background())
+			if testCase.expectedErrKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedBaseUrl, baseUrl)
+		})
+	}
+}
+
+func TestCallbackEndpointResolver(t *testing.T) {
+	resolver := NewCallbackEndpointResolver(func(ctx context.Context) (string, errors.EdgeX) {
+		return testBaseUrl, nil
+	})
+	baseUrl, err := resolver.Resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, testBaseUrl, baseUrl)
+
+	resolver = NewCallbackEndpointResolver(func(ctx context.Context) (string, errors.EdgeX) {
+		return "", errors.NewCommonEdgeX(errors.KindServiceUnavailable, "registry unavailable", nil)
+	})
+	_, err = resolver.Resolve(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+}
+
+func TestCachedEndpointResolver(t *testing.T) {
+	resolveErr := errors.NewCommonEdgeX(errors.KindServiceUnavailable, "registry unavailable", nil)
+	mockResolver := &mocks.EndpointResolver{}
+	mockResolver.On("Resolve", mock.Anything).Return("", resolveErr).Once()
+	mockResolver.On("Resolve", mock.Anything).Return(testBaseUrl, nil).Once()
+	mockResolver.On("Resolve", mock.Anything).Return("", resolveErr).Once()
+	mockResolver.On("Resolve", mock.Anything).Return("http://localhost:59881", nil).Once()
+
+	resolver := NewCachedEndpointResolver(mockResolver, 50*time.Millisecond)
+
+	// No base URL was resolved yet, so the error is returned
+	_, err := resolver.Resolve(context.Background())
+	require.Error(t, err)
+
+	baseUrl, err := resolver.Resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, testBaseUrl, baseUrl)
+
+	// The cached base URL is returned without consulting the resolver until the refresh interval elapses
+	baseUrl, err = resolver.Resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, testBaseUrl, baseUrl)
+	mockResolver.AssertNumberOfCalls(t, "Resolve", 2)
+
+	// The refresh fails, so the last known good base URL is returned
+	time.Sleep(60 * time.Millisecond)
+	baseUrl, err = resolver.Resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, testBaseUrl, baseUrl)
+
+	time.Sleep(60 * time.Millisecond)
+	baseUrl, err = resolver.Resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:59881", baseUrl)
+	mockResolver.AssertExpectations(t)
+}
+
+func TestCachedEndpointResolverSingleRefresh(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	resolver := NewCachedEndpointResolver(NewCallbackEndpointResolver(func(ctx context.Context) (string, errors.EdgeX) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return testBaseUrl, nil
+	}), time.Minute)
+
+	const callers = 10
+	var wg sync.WaitGroup
+	results := make(chan string, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			baseUrl, err := resolver.Resolve(context.Background())
+			assert.NoError(t, err)
+			results <- baseUrl
+		}()
+	}
+	// The callers wait for the refresh without holding the lock
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&calls) == 1 }, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(results)
+
+	for baseUrl := range results {
+		assert.Equal(t, testBaseUrl, baseUrl)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestCachedEndpointResolverCallerCancelled(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	resolver := NewCachedEndpointResolver(NewCallbackEndpointResolver(func(ctx context.Context) (string, errors.EdgeX) {
+		close(started)
+		<-release
+		if ctx.Err() != nil {
+			return "", errors.NewCommonEdgeX(errors.KindServiceUnavailable, "resolution cancelled", ctx.Err())
+		}
+		return testBaseUrl, nil
+	}), time.Minute)
+
+	// The caller starting the refresh gives up
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := make(chan errors.EdgeX)
+	go func() {
+		_, err := resolver.Resolve(ctx)
+		cancelled <- err
+	}()
+	<-started
+	cancel()
+	err := <-cancelled
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+
+	// The refresh goes on for the other callers
+	resolved := make(chan string)
+	go func() {
+		baseUrl, err := resolver.Resolve(context.Background())
+		assert.NoError(t, err)
+		resolved <- baseUrl
+	}()
+	close(release)
+	assert.Equal(t, testBaseUrl, <-resolved)
+}
+
+func TestCachedEndpointResolverDefaultRefreshInterval(t *testing.T) {
+	resolver := NewCachedEndpointResolver(NewStaticEndpointResolver(testBaseUrl), 0).(*cachedEndpointResolver)
+	assert.Equal(t, common.ClientMonitorDefault*time.Millisecond, resolver.refreshInterval)
+}
+
+func TestWithEndpointResolver(t *testing.T) {
+	newServer := func(serviceName string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(dtoCommon.BaseWithServiceNameResponse{ServiceName: serviceName})
+		}))
+	}
+	primary := newServer("primary")
+	defer primary.Close()
+	secondary := newServer("secondary")
+	defer secondary.Close()
+
+	dir := t.TempDir()
+	endpointFile := filepath.Join(dir, "endpoint")
+	require.NoError(t, os.WriteFile(endpointFile, []byte(primary.URL), 0600))
+	option := WithEndpointResolver(NewFileEndpointResolver(endpointFile), 10*time.Millisecond)
+
+	var res dtoCommon.BaseWithServiceNameResponse
+	err := GetRequest(context.Background(), &res, "", common.ApiPingRoute, nil, option)
+	require.NoError(t, err)
+	assert.Equal(t, "primary", res.ServiceName)
+
+	// The requests follow the endpoint once the cached base URL is refreshed
+	require.NoError(t, os.WriteFile(endpointFile, []byte(secondary.URL), 0600))
+	time.Sleep(20 * time.Millisecond)
+	err = GetRequest(context.Background(), &res, "", common.ApiPingRoute, nil, option)
+	require.NoError(t, err)
+	assert.Equal(t, "secondary", res.ServiceName)
+
+	// The last known good base URL is used when the endpoint can no longer be resolved
+	require.NoError(t, os.Remove(endpointFile))
+	time.Sleep(20 * time.Millisecond)
+	err = GetRequest(context.Background(), &res, "", common.ApiPingRoute, nil, option)
+	require.NoError(t, err)
+	assert.Equal(t, "secondary", res.ServiceName)
+
+	// The base URL given to the client is used when the resolver never succeeded
+	option = WithEndpointResolver(NewFileEndpointResolver(endpointFile), 0)
+	err = GetRequest(context.Background(), &res, primary.URL, common.ApiPingRoute, nil, option)
+	require.NoError(t, err)
+	assert.Equal(t, "primary", res.ServiceName)
+	err = GetRequest(context.Background(), &res, "", common.ApiPingRoute, nil, option)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindIOError, errors.Kind(err))
+
+	// The resolver is ignored once cancelled by WithoutEndpointResolver
+	err = GetRequest(context.Background(), &res, secondary.URL, common.ApiPingRoute, nil, WithEndpointResolver(NewStaticEndpointResolver(primary.URL), 0), WithoutEndpointResolver())
+	require.NoError(t, err)
+	assert.Equal(t, "secondary", res.ServiceName)
+}
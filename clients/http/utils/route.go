@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"net/url"
+	"path"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
+)
+
+// UnknownRoute is the route reported to the span hooks and the metrics for the requests matching no known route
+const UnknownRoute = "unknown"
+
+// routeTemplate returns the template of the route matching the escaped request path, which is reported to the
+// instrumentation hooks instead of the expanded request path so that requests to the same API are grouped together.
+// UnknownRoute is returned if no route matches, so that custom paths cannot create an unbounded number of routes.
+func routeTemplate(requestPath string) string {
+	route, _, ok := routes.Match(requestPath)
+	if !ok {
+		return UnknownRoute
+	}
+	return route.Template
+}
+
+// joinPath joins the request path, whose segments are escaped as done by routes.Build, to the path of the URL. A request
+// path which is not a valid escaped path is joined as is.
+func joinPath(u *url.URL, requestPath string) *url.URL {
+	escapedPath := path.Join(u.EscapedPath(), requestPath)
+	unescapedPath, err := url.PathUnescape(escapedPath)
+	if err != nil {
+		u.Path = path.Join(u.Path, requestPath)
+		return u
+	}
+	u.Path, u.RawPath = unescapedPath, escapedPath
+	return u
+}
@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+)
+
+func TestRouteTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		requestPath string
+		expected    string
+	}{
+		{"static route", common.ApiAllDeviceRoute, common.ApiAllDeviceRoute},
+		{"route with a parameter", common.ApiDeviceRoute + "/name/Random-Integer-Device", common.ApiDeviceByNameRoute},
+		{"route with several parameters", common.ApiReadingRoute + "/device/name/Random-Integer-Device/resourceName/Int8", common.ApiReadingByDeviceNameAndResourceNameRoute},
+		{"literal segments take precedence", common.ApiEventRoute + "/device/name/Random-Integer-Device", common.ApiEventByDeviceNameRoute},
+		{"parameters only", common.ApiEventRoute + "/profile/device/source", common.ApiEventProfileNameDeviceNameSourceNameRoute},
+		{"device command", common.ApiDeviceRoute + "/name/Random-Integer-Device/Int8", common.ApiDeviceNameCommandNameRoute},
+		{"base URL path prefix", "/core-data" + common.ApiEventRoute + "/id/82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc", common.ApiEventIdRoute},
+		{"unknown route", "/custom/route", UnknownRoute},
+		{"unknown route with parameters", "/custom/route/a%2Fb/42", UnknownRoute},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, routeTemplate(testCase.requestPath))
+		})
+	}
+}
+
+func TestJoinPath(t *testing.T) {
+	tests := []struct {
+		name            string
+		baseUrl         string
+		requestPath     string
+		expectedPath    string
+		expectedEscaped string
+	}{
+		{"plain path", "http://localhost:59881", "/api/v2/device/name/device", "/api/v2/device/name/device", "/api/v2/device/name/device"},
+		{"escaped path", "http://localhost:59881", "/api/v2/device/name/my%20device%2F1", "/api/v2/device/name/my device/1", "/api/v2/device/name/my%20device%2F1"},
+		{"base URL path prefix", "http://localhost/core-metadata", "/api/v2/device/name/a%2Bb", "/core-metadata/api/v2/device/name/a+b", "/core-metadata/api/v2/device/name/a%2Bb"},
+		{"unescaped path", "http://localhost:59881", "/api/v2/device/name/100%", "/api/v2/device/name/100%", "/api/v2/device/name/100%25"},
+		{"unescaped name read as escaped", "http://localhost:59881", "/api/v2/device/name/a%41", "/api/v2/device/name/aA", "/api/v2/device/name/a%41"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			u, err := url.Parse(testCase.baseUrl)
+			require.NoError(t, err)
+			u = joinPath(u, testCase.requestPath)
+			assert.Equal(t, testCase.expectedPath, u.Path)
+			assert.Equal(t, testCase.expectedEscaped, u.EscapedPath())
+		})
+	}
+}
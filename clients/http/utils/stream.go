@@ -0,0 +1,352 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// GetRequestStream makes the get request and decodes the response body while it is being read, which avoids holding
+// large list responses such as MultiReadingsResponse in memory.
+// The fields of the top-level response object are decoded into returnValuePointer, except for arrayField whose elements
+// are decoded one at a time into the value returned by newElement and passed to handleElement.
+// Returning an error from handleElement stops the decoding and the error is returned to the caller.
+func GetRequestStream(
+	ctx context.Context,
+	returnValuePointer interface{},
+	baseUrl string, requestPath string,
+	requestParams url.Values,
+	arrayField string,
+	newElement func() interface{},
+	handleElement func(element interface{}) errors.EdgeX,
+	opts ...ClientOption) errors.EdgeX {
+
+	o := newClientOptions(opts)
+	req, err := createRequest(ctx, http.MethodGet, baseUrl, requestPath, requestParams, o)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	resp, err := makeRequest(ctx, req, o)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > http.StatusMultiStatus {
+		body, err := getBody(resp, o)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		msg := fmt.Sprintf("request failed, status code: %d, err: %s", resp.StatusCode, string(body))
+		return errors.NewCommonEdgeX(errors.KindMapping(resp.StatusCode), msg, nil)
+	}
+
+	body := limitReader(resp.Body, o.maxResponseSize)
+	if strings.HasPrefix(resp.Header.Get(common.ContentType), common.ContentTypeCBOR) {
+		err = decodeCBORStream(body, returnValuePointer, arrayField, newElement, handleElement)
+	} else {
+		err = decodeJSONStream(body, returnValuePointer, arrayField, newElement, handleElement)
+	}
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// decodeJSONStream walks the top-level JSON object token by token so that only one element of arrayField is held in
+// memory at a time. The remaining fields are collected and decoded into returnValuePointer once the object ends.
+func decodeJSONStream(
+	r io.Reader,
+	returnValuePointer interface{},
+	arrayField string,
+	newElement func() interface{},
+	handleElement func(element interface{}) errors.EdgeX) errors.EdgeX {
+
+	decoder := json.NewDecoder(r)
+	token, err := decoder.Token()
+	if err == io.EOF {
+		// Empty response body, nothing to decode
+		return nil
+	} else if err != nil {
+		return streamDecodeError(err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body, expected a JSON object", nil)
+	}
+
+	fields := make(map[string]json.RawMessage)
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return streamDecodeError(err)
+		}
+		key, _ := token.(string)
+		if !strings.EqualFold(key, arrayField) {
+			var raw json.RawMessage
+			if err = decoder.Decode(&raw); err != nil {
+				return streamDecodeError(err)
+			}
+			fields[key] = raw
+			continue
+		}
+
+		token, err = decoder.Token()
+		if err != nil {
+			return streamDecodeError(err)
+		}
+		if token == nil {
+			// The array field is null
+			continue
+		}
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the response body, expected %s to be an array", arrayField), nil)
+		}
+		for decoder.More() {
+			element := newElement()
+			if err = decoder.Decode(element); err != nil {
+				return streamDecodeError(err)
+			}
+			if edgexErr := handleElement(element); edgexErr != nil {
+				return errors.NewCommonEdgeXWrapper(edgexErr)
+			}
+		}
+		// Consume the closing bracket of the array
+		if _, err = decoder.Token(); err != nil {
+			return streamDecodeError(err)
+		}
+	}
+
+	rest, err := json.Marshal(fields)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
+	}
+	if err = json.Unmarshal(rest, returnValuePointer); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
+	}
+	return nil
+}
+
+// decodeCBORStream walks the top-level CBOR map item by item so that only one element of arrayField is held in memory
+// at a time, like decodeJSONStream. The CBOR decoder has no token level API, so the data items are delimited by
+// readCBORItem and each of them is decoded on its own.
+func decodeCBORStream(
+	r io.Reader,
+	returnValuePointer interface{},
+	arrayField string,
+	newElement func() interface{},
+	handleElement func(element interface{}) errors.EdgeX) errors.EdgeX {
+
+	br := bufio.NewReader(r)
+	header, err := readCBORHeader(br)
+	if err == io.EOF {
+		// Empty response body, nothing to decode
+		return nil
+	} else if err != nil {
+		return streamDecodeError(err)
+	}
+	if header.major != cborMajorMap {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the cbor response, expected a map", nil)
+	}
+
+	fields := make(map[string]cbor.RawMessage)
+	for i := uint64(0); header.indefinite || i < header.value; i++ {
+		rawKey, err := readCBORItem(br, 0)
+		if err == errCBORBreak && header.indefinite {
+			break
+		} else if err != nil {
+			return streamDecodeError(err)
+		}
+		var key string
+		if err = cbor.Unmarshal(rawKey, &key); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the cbor response, expected a string key", err)
+		}
+		if !strings.EqualFold(key, arrayField) {
+			raw, err := readCBORItem(br, 0)
+			if err != nil {
+				return streamDecodeError(err)
+			}
+			fields[key] = raw
+			continue
+		}
+
+		array, err := readCBORHeader(br)
+		if err != nil {
+			return streamDecodeError(err)
+		}
+		if array.major == cborMajorSimple && array.value == cborNull {
+			// The array field is null
+			continue
+		}
+		if array.major != cborMajorArray {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode the cbor response, expected %s to be an array", arrayField), nil)
+		}
+		for j := uint64(0); array.indefinite || j < array.value; j++ {
+			raw, err := readCBORItem(br, 1)
+			if err == errCBORBreak && array.indefinite {
+				break
+			} else if err != nil {
+				return streamDecodeError(err)
+			}
+			element := newElement()
+			if err = cbor.Unmarshal(raw, element); err != nil {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the cbor response", err)
+			}
+			if edgexErr := handleElement(element); edgexErr != nil {
+				return errors.NewCommonEdgeXWrapper(edgexErr)
+			}
+		}
+	}
+
+	rest, err := cbor.Marshal(fields)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the cbor response", err)
+	}
+	if err = cbor.Unmarshal(rest, returnValuePointer); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the cbor response", err)
+	}
+	return nil
+}
+
+// The CBOR major types and simple values used by the stream decoding, see RFC 8949
+const (
+	cborMajorBytes  = 2
+	cborMajorText   = 3
+	cborMajorArray  = 4
+	cborMajorMap    = 5
+	cborMajorTag    = 6
+	cborMajorSimple = 7
+	cborNull        = 22
+	// cborMaxNestedLevels is the nesting limit of the cbor decoder, which also bounds the recursion of readCBORItem
+	cborMaxNestedLevels = 32
+)
+
+// errCBORBreak is returned by readCBORItem when it reads the break code closing an indefinite length item
+var errCBORBreak = fmt.Errorf("unexpected cbor break code")
+
+type cborHeader struct {
+	major      byte
+	value      uint64
+	indefinite bool
+	raw        []byte
+}
+
+// readCBORHeader reads the initial byte of a data item and its argument, i.e. the length of strings, arrays and maps
+func readCBORHeader(r *bufio.Reader) (cborHeader, error) {
+	initial, err := r.ReadByte()
+	if err != nil {
+		return cborHeader{}, err
+	}
+	h := cborHeader{major: initial >> 5, raw: []byte{initial}}
+	info := initial & 0x1f
+	switch {
+	case info < 24:
+		h.value = uint64(info)
+	case info <= 27:
+		size := 1 << (info - 24)
+		arg := make([]byte, size)
+		if _, err = io.ReadFull(r, arg); err != nil {
+			return cborHeader{}, unexpectedEOF(err)
+		}
+		for _, b := range arg {
+			h.value = h.value<<8 | uint64(b)
+		}
+		h.raw = append(h.raw, arg...)
+		if h.major >= cborMajorBytes && h.major <= cborMajorMap && h.value > math.MaxInt64 {
+			// The length of a string, an array or a map cannot be read, nor held, in memory
+			return cborHeader{}, fmt.Errorf("invalid cbor length %d", h.value)
+		}
+	case info == 31:
+		if h.major == cborMajorSimple {
+			return h, errCBORBreak
+		}
+		if h.major < cborMajorBytes || h.major == cborMajorTag {
+			return cborHeader{}, fmt.Errorf("invalid indefinite length for the cbor major type %d", h.major)
+		}
+		h.indefinite = true
+	default:
+		return cborHeader{}, fmt.Errorf("invalid cbor additional information %d", info)
+	}
+	return h, nil
+}
+
+// readCBORItem reads a complete data item and returns its encoding
+func readCBORItem(r *bufio.Reader, level int) ([]byte, error) {
+	if level > cborMaxNestedLevels {
+		return nil, fmt.Errorf("the cbor data exceeds %d nested levels", cborMaxNestedLevels)
+	}
+	h, err := readCBORHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	item := h.raw
+	appendItems := func(count uint64) error {
+		for i := uint64(0); h.indefinite || i < count; i++ {
+			child, err := readCBORItem(r, level+1)
+			if err == errCBORBreak && h.indefinite {
+				item = append(item, 0xff)
+				return nil
+			} else if err != nil {
+				return unexpectedEOF(err)
+			}
+			item = append(item, child...)
+		}
+		return nil
+	}
+
+	switch h.major {
+	case cborMajorBytes, cborMajorText:
+		if h.indefinite {
+			// The chunks of an indefinite length string are definite length strings
+			err = appendItems(0)
+			break
+		}
+		var content bytes.Buffer
+		if _, err = io.CopyN(&content, r, int64(h.value)); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		item = append(item, content.Bytes()...)
+	case cborMajorArray:
+		err = appendItems(h.value)
+	case cborMajorMap:
+		err = appendItems(2 * h.value)
+	case cborMajorTag:
+		err = appendItems(1)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// streamDecodeError keeps the KindLimitExceeded error raised by limitReader, other errors mean the body is malformed
+func streamDecodeError(err error) errors.EdgeX {
+	if errors.Kind(err) == errors.KindLimitExceeded {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
+}
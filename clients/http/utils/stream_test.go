@@ -0,0 +1,165 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+type testStreamResponse struct {
+	ApiVersion string `json:"apiVersion"`
+	TotalCount int    `json:"totalCount"`
+}
+
+type testStreamElement struct {
+	Name  string   `json:"name"`
+	Value []string `json:"value"`
+}
+
+func cborItem(t *testing.T, v interface{}) []byte {
+	data, err := cbor.Marshal(v)
+	require.NoError(t, err)
+	return data
+}
+
+// cborStreamBody encodes {"apiVersion": "v3", "totalCount": n, "readings": [elements...]} with an indefinite length
+// array when indefinite is true, returning the encoding of the map header, of the fields and of each element
+func cborStreamBody(t *testing.T, indefinite bool, elements ...testStreamElement) [][]byte {
+	parts := [][]byte{
+		{0xa3},
+		cborItem(t, "apiVersion"), cborItem(t, "v3"),
+		cborItem(t, "totalCount"), cborItem(t, len(elements)),
+		cborItem(t, "readings"),
+	}
+	if indefinite {
+		parts = append(parts, []byte{0x9f})
+	} else {
+		parts = append(parts, []byte{0x80 | byte(len(elements))})
+	}
+	for _, e := range elements {
+		parts = append(parts, cborItem(t, e))
+	}
+	if indefinite {
+		parts = append(parts, []byte{0xff})
+	}
+	return parts
+}
+
+func decodeTestCBORStream(body io.Reader, handle func(e testStreamElement)) (testStreamResponse, errors.EdgeX) {
+	var res testStreamResponse
+	err := decodeCBORStream(body, &res, "readings",
+		func() interface{} { return &testStreamElement{} },
+		func(element interface{}) errors.EdgeX {
+			handle(*element.(*testStreamElement))
+			return nil
+		})
+	return res, err
+}
+
+func TestDecodeCBORStream(t *testing.T) {
+	elements := []testStreamElement{{Name: "first", Value: []string{"a", "b"}}, {Name: "second"}}
+	tests := []struct {
+		name       string
+		indefinite bool
+	}{
+		{"definite length array", false},
+		{"indefinite length array", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			body := bytes.Join(cborStreamBody(t, testCase.indefinite, elements...), nil)
+
+			var handled []testStreamElement
+			res, err := decodeTestCBORStream(bytes.NewReader(body), func(e testStreamElement) { handled = append(handled, e) })
+
+			require.NoError(t, err)
+			assert.Equal(t, testStreamResponse{ApiVersion: "v3", TotalCount: 2}, res)
+			assert.Equal(t, elements, handled)
+		})
+	}
+}
+
+func TestDecodeCBORStreamIsIncremental(t *testing.T) {
+	parts := cborStreamBody(t, false, testStreamElement{Name: "first"}, testStreamElement{Name: "second"})
+	last := len(parts) - 1
+
+	reader, writer := io.Pipe()
+	firstHandled := make(chan struct{})
+	go func() {
+		// The second element is only written once the first one is handled, which never happens when the decoder waits
+		// for the whole body
+		_, _ = writer.Write(bytes.Join(parts[:last], nil))
+		select {
+		case <-firstHandled:
+			_, _ = writer.Write(parts[last])
+			_ = writer.Close()
+		case <-time.After(5 * time.Second):
+			_ = writer.CloseWithError(fmt.Errorf("the first element was not handled before the end of the body"))
+		}
+	}()
+
+	var handled []string
+	_, err := decodeTestCBORStream(reader, func(e testStreamElement) {
+		handled = append(handled, e.Name)
+		if len(handled) == 1 {
+			close(firstHandled)
+		}
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, handled)
+}
+
+func TestDecodeCBORStreamError(t *testing.T) {
+	body := bytes.Join(cborStreamBody(t, false, testStreamElement{Name: "first"}), nil)
+	tests := []struct {
+		name string
+		body []byte
+	}{
+		{"not a map", cborItem(t, []string{"a"})},
+		{"truncated", body[:len(body)-2]},
+		{"array field is not an array", bytes.Join([][]byte{{0xa1}, cborItem(t, "readings"), cborItem(t, "a")}, nil)},
+		{"break in definite length map", []byte{0xa1, 0xff}},
+		{"string length overflow", []byte{0xa1, 0x7b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := decodeTestCBORStream(bytes.NewReader(testCase.body), func(e testStreamElement) {})
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+}
+
+func TestReadCBORItemLengthOverflow(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"bytes", []byte{0x5b, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		{"text", []byte{0x7b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{"array", []byte{0x9b, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		{"map", []byte{0xbb, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := readCBORItem(bufio.NewReader(bytes.NewReader(testCase.data)), 0)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "invalid cbor length")
+		})
+	}
+}
@@ -0,0 +1,155 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// TLSConfig defines the TLS settings of the REST clients
+type TLSConfig struct {
+	// CAFile is the path of a PEM encoded CA bundle used to verify the server certificates instead of the system roots
+	CAFile string
+	// CertFile and KeyFile are the paths of the PEM encoded client certificate and private key presented to the server
+	// for mutual TLS. Both files are reloaded whenever they change, so a renewed certificate is used by new connections
+	// without restarting the service.
+	CertFile string
+	KeyFile  string
+	// ServerName overrides the host name used to verify the server certificate
+	ServerName string
+	// MinVersion is the minimum TLS version accepted, e.g. tls.VersionTLS13. It defaults to tls.VersionTLS12.
+	MinVersion uint16
+}
+
+// WithTLSConfig makes the requests with the given TLS settings. The configuration files are loaded by the first request,
+// which fails with a KindIOError or KindContractInvalid error if they are invalid. The files are loaded again by the next
+// requests until they succeed, e.g. once a certificate is mounted after the service started.
+func WithTLSConfig(config TLSConfig) ClientOption {
+	// The transport is shared by all requests using this option so that connections can be reused
+	transport := &tlsTransport{config: config}
+	return func(o *clientOptions) {
+		o.tlsTransport = transport
+	}
+}
+
+// tlsTransport lazily builds the http.Transport of a TLSConfig, only a successful build being kept, and its unix domain
+// socket capable clone
+type tlsTransport struct {
+	config        TLSConfig
+	mutex         sync.Mutex
+	transport     *http.Transport
+	unixTransport *http.Transport
+}
+
+// get returns the transport of the TLSConfig, or its clone dialing the unix domain sockets if unix is true
+func (t *tlsTransport) get(unix bool) (*http.Transport, errors.EdgeX) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.transport == nil {
+		transport, err := newTLSTransport(t.config)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		t.transport = transport
+	}
+	if !unix {
+		return t.transport, nil
+	}
+	if t.unixTransport == nil {
+		t.unixTransport = newUnixTransport(t.transport)
+	}
+	return t.unixTransport, nil
+}
+
+func newTLSTransport(config TLSConfig) (*http.Transport, errors.EdgeX) {
+	tlsConfig := &tls.Config{
+		ServerName: config.ServerName,
+		MinVersion: config.MinVersion,
+	}
+	if tlsConfig.MinVersion == 0 {
+		tlsConfig.MinVersion = tls.VersionTLS12
+	}
+
+	if config.CAFile != "" {
+		caCerts, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("failed to read the CA bundle from %s", config.CAFile), err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCerts) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no valid PEM certificate found in %s", config.CAFile), nil)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.CertFile != "" || config.KeyFile != "" {
+		loader := &certificateLoader{certFile: config.CertFile, keyFile: config.KeyFile}
+		// Load the certificate upfront to report an invalid key pair immediately
+		if _, err := loader.getClientCertificate(nil); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		tlsConfig.GetClientCertificate = loader.getClientCertificate
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// certificateLoader provides the client certificate to the TLS handshakes and reloads it when the files are modified
+type certificateLoader struct {
+	certFile    string
+	keyFile     string
+	mutex       sync.Mutex
+	certificate *tls.Certificate
+	certStat    fileStat
+	keyStat     fileStat
+}
+
+type fileStat struct {
+	modTime time.Time
+	size    int64
+}
+
+func statFile(file string) (fileStat, errors.EdgeX) {
+	info, err := os.Stat(file)
+	if err != nil {
+		return fileStat{}, errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("failed to stat %s", file), err)
+	}
+	return fileStat{modTime: info.ModTime(), size: info.Size()}, nil
+}
+
+func (l *certificateLoader) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	certStat, certErr := statFile(l.certFile)
+	keyStat, keyErr := statFile(l.keyFile)
+	if certErr == nil && keyErr == nil && l.certificate != nil && certStat == l.certStat && keyStat == l.keyStat {
+		return l.certificate, nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(l.certFile, l.keyFile)
+	if err != nil {
+		// Keep presenting the previous certificate while the files are being rewritten
+		if l.certificate != nil {
+			return l.certificate, nil
+		}
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to load the client certificate from %s and %s", l.certFile, l.keyFile), err)
+	}
+	l.certificate = &certificate
+	l.certStat = certStat
+	l.keyStat = keyStat
+	return l.certificate, nil
+}
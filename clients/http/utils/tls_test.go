@@ -0,0 +1,214 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+const testServerName = "core-data.edgex"
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "EdgeX Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns the PEM encoded certificate and key signed by the CA
+func (ca testCA) issue(t *testing.T, commonName string, usage x509.ExtKeyUsage, dnsNames ...string) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     dnsNames,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func writeTestFile(t *testing.T, file string, data []byte, modTime time.Time) {
+	require.NoError(t, os.WriteFile(file, data, 0600))
+	require.NoError(t, os.Chtimes(file, modTime, modTime))
+}
+
+// newMutualTLSServer starts a server which requires a client certificate signed by the CA and replies with its common name
+func newMutualTLSServer(t *testing.T, ca testCA) *httptest.Server {
+	serverCert, serverKey := ca.issue(t, testServerName, x509.ExtKeyUsageServerAuth, testServerName)
+	certificate, err := tls.X509KeyPair(serverCert, serverKey)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Close the connection so that every request performs a new handshake
+		w.Header().Set("Connection", "close")
+		w.Header().Set(common.ContentType, common.ContentTypeJSON)
+		_ = json.NewEncoder(w).Encode(r.TLS.PeerCertificates[0].Subject.CommonName)
+	}))
+	ts.TLS = &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+		MinVersion:   tls.VersionTLS12,
+	}
+	ts.StartTLS()
+	return ts
+}
+
+func TestWithTLSConfig(t *testing.T) {
+	ca := newTestCA(t)
+	ts := newMutualTLSServer(t, ca)
+	defer ts.Close()
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client.key")
+	writeTestFile(t, caFile, ca.pem, time.Now())
+	cert, key := ca.issue(t, "device-virtual", x509.ExtKeyUsageClientAuth)
+	writeTestFile(t, certFile, cert, time.Now())
+	writeTestFile(t, keyFile, key, time.Now())
+
+	tests := []struct {
+		name            string
+		config          TLSConfig
+		expectedErrKind errors.ErrKind
+	}{
+		{"valid", TLSConfig{CAFile: caFile, CertFile: certFile, KeyFile: keyFile, ServerName: testServerName}, ""},
+		{"valid with TLS 1.3", TLSConfig{CAFile: caFile, CertFile: certFile, KeyFile: keyFile, ServerName: testServerName, MinVersion: tls.VersionTLS13}, ""},
+		{"invalid - server name mismatch", TLSConfig{CAFile: caFile, CertFile: certFile, KeyFile: keyFile}, errors.KindServiceUnavailable},
+		{"invalid - untrusted server", TLSConfig{CertFile: certFile, KeyFile: keyFile, ServerName: testServerName}, errors.KindServiceUnavailable},
+		{"invalid - no client certificate", TLSConfig{CAFile: caFile, ServerName: testServerName}, errors.KindServiceUnavailable},
+		{"invalid - CA file not found", TLSConfig{CAFile: filepath.Join(dir, "missing.pem")}, errors.KindIOError},
+		{"invalid - CA file without certificate", TLSConfig{CAFile: keyFile}, errors.KindContractInvalid},
+		{"invalid - key pair mismatch", TLSConfig{CAFile: caFile, CertFile: certFile, KeyFile: caFile}, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var commonName string
+			err := GetRequest(context.Background(), &commonName, ts.URL, common.ApiPingRoute, nil, WithTLSConfig(testCase.config))
+			if testCase.expectedErrKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "device-virtual", commonName)
+		})
+	}
+}
+
+func TestWithTLSConfigReloadsClientCertificate(t *testing.T) {
+	ca := newTestCA(t)
+	ts := newMutualTLSServer(t, ca)
+	defer ts.Close()
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client.key")
+	writeTestFile(t, caFile, ca.pem, time.Now())
+	cert, key := ca.issue(t, "client-1", x509.ExtKeyUsageClientAuth)
+	writeTestFile(t, certFile, cert, time.Now())
+	writeTestFile(t, keyFile, key, time.Now())
+
+	option := WithTLSConfig(TLSConfig{CAFile: caFile, CertFile: certFile, KeyFile: keyFile, ServerName: testServerName})
+	var commonName string
+	err := GetRequest(context.Background(), &commonName, ts.URL, common.ApiPingRoute, nil, option)
+	require.NoError(t, err)
+	assert.Equal(t, "client-1", commonName)
+
+	// Renew the certificate, the new one should be presented without creating a new option
+	renewed := time.Now().Add(time.Minute)
+	cert, key = ca.issue(t, "client-2", x509.ExtKeyUsageClientAuth)
+	writeTestFile(t, certFile, cert, renewed)
+	writeTestFile(t, keyFile, key, renewed)
+	err = GetRequest(context.Background(), &commonName, ts.URL, common.ApiPingRoute, nil, option)
+	require.NoError(t, err)
+	assert.Equal(t, "client-2", commonName)
+
+	// A broken key pair keeps the previous certificate in use
+	writeTestFile(t, keyFile, []byte("invalid"), renewed.Add(time.Minute))
+	err = GetRequest(context.Background(), &commonName, ts.URL, common.ApiPingRoute, nil, option)
+	require.NoError(t, err)
+	assert.Equal(t, "client-2", commonName)
+}
+
+func TestWithTLSConfigRetriesFailedLoad(t *testing.T) {
+	ca := newTestCA(t)
+	ts := newMutualTLSServer(t, ca)
+	defer ts.Close()
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client.key")
+	writeTestFile(t, caFile, ca.pem, time.Now())
+	option := WithTLSConfig(TLSConfig{CAFile: caFile, CertFile: certFile, KeyFile: keyFile, ServerName: testServerName})
+
+	// The client certificate is not mounted yet
+	var commonName string
+	err := GetRequest(context.Background(), &commonName, ts.URL, common.ApiPingRoute, nil, option)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	cert, key := ca.issue(t, "device-virtual", x509.ExtKeyUsageClientAuth)
+	writeTestFile(t, certFile, cert, time.Now())
+	writeTestFile(t, keyFile, key, time.Now())
+	err = GetRequest(context.Background(), &commonName, ts.URL, common.ApiPingRoute, nil, option)
+	require.NoError(t, err)
+	assert.Equal(t, "device-virtual", commonName)
+}
@@ -0,0 +1,118 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// traceParentLength is the length of a version 00 traceparent, e.g. 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01
+const traceParentLength = 55
+
+// Span describes a request sent by the clients to the span hooks
+type Span struct {
+	// Method is the HTTP method of the request
+	Method string
+	// Route is the route template of the request, e.g. /api/v2/device/name/{name}, rather than the expanded path, or
+	// UnknownRoute when the request path matches no route
+	Route string
+	// StatusCode is the status code of the response, 0 if no response was received
+	StatusCode int
+	// ErrKind is the kind of the error the request failed with, empty if it succeeded
+	ErrKind errors.ErrKind
+}
+
+// SpanHooks are the callbacks invoked around every request, so that any tracing backend can record the requests
+// without this module depending on it
+type SpanHooks struct {
+	// Start is called before the request is sent, with the StatusCode and ErrKind of the span left empty.
+	// The returned context is passed to End and its traceparent and tracestate values are propagated as the request
+	// headers, so a hook starting a child span should return a context holding the trace context of that span.
+	Start func(ctx context.Context, span Span) context.Context
+	// End is called once the response headers are received or the request failed
+	End func(ctx context.Context, span Span)
+}
+
+// WithSpanHooks invokes the span hooks around every request. Either hook may be nil.
+func WithSpanHooks(hooks SpanHooks) ClientOption {
+	return func(o *clientOptions) {
+		o.spanHooks = hooks
+	}
+}
+
+// startSpan invokes the Start hook if there is one and returns the context to propagate and pass to endSpan
+func (o *clientOptions) startSpan(ctx context.Context, span Span) context.Context {
+	if o.spanHooks.Start == nil {
+		return ctx
+	}
+	if spanCtx := o.spanHooks.Start(ctx, span); spanCtx != nil {
+		return spanCtx
+	}
+	return ctx
+}
+
+// endSpan invokes the End hook if there is one
+func (o *clientOptions) endSpan(ctx context.Context, span Span, statusCode int, errKind errors.ErrKind) {
+	if o.spanHooks.End == nil {
+		return
+	}
+	span.StatusCode = statusCode
+	span.ErrKind = errKind
+	o.spanHooks.End(ctx, span)
+}
+
+// setTraceHeaders propagates the W3C Trace Context of the supplied context as the traceparent and tracestate headers.
+// An invalid traceparent is not propagated, nor is the tracestate without a valid traceparent.
+func setTraceHeaders(ctx context.Context, req *http.Request) {
+	traceParent := strings.TrimSpace(FromContext(ctx, common.TraceParentHeader))
+	if !validTraceParent(traceParent) {
+		return
+	}
+	req.Header.Set(common.TraceParentHeader, traceParent)
+	if traceState := strings.TrimSpace(FromContext(ctx, common.TraceStateHeader)); traceState != "" {
+		req.Header.Set(common.TraceStateHeader, traceState)
+	}
+}
+
+// validTraceParent checks the traceparent against the W3C Trace Context format version-traceid-parentid-flags.
+// Versions later than 00 may append fields, which are kept as is.
+func validTraceParent(traceParent string) bool {
+	if len(traceParent) < traceParentLength {
+		return false
+	}
+	version := traceParent[0:2]
+	if version == "00" && len(traceParent) != traceParentLength {
+		return false
+	}
+	if len(traceParent) > traceParentLength && traceParent[traceParentLength] != '-' {
+		return false
+	}
+	fields := strings.Split(traceParent[:traceParentLength], "-")
+	if len(fields) != 4 || version == "ff" {
+		return false
+	}
+	for i, length := range []int{2, 32, 16, 2} {
+		if len(fields[i]) != length || !isLowerHex(fields[i]) {
+			return false
+		}
+	}
+	// All zeros trace id and parent id are invalid
+	return strings.Trim(fields[1], "0") != "" && strings.Trim(fields[2], "0") != ""
+}
+
+func isLowerHex(s string) bool {
+	if strings.ToLower(s) != s {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
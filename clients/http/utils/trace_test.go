@@ -0,0 +1,146 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+const (
+	testTraceParent      = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	testChildTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-b7ad6b7169203331-01"
+	testTraceState       = "congo=t61rcWkgMzE,rojo=00f067aa0ba902b7"
+)
+
+// newTraceServer replies with the trace headers of the request, or with the given status code if it is not 200
+func newTraceServer(statusCode int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			common.TraceParentHeader: r.Header.Get(common.TraceParentHeader),
+			common.TraceStateHeader:  r.Header.Get(common.TraceStateHeader),
+			common.CorrelationHeader: r.Header.Get(common.CorrelationHeader),
+		})
+	}))
+}
+
+func TestTraceContextPropagation(t *testing.T) {
+	ts := newTraceServer(http.StatusOK)
+	defer ts.Close()
+
+	tests := []struct {
+		name                string
+		traceParent         string
+		traceState          string
+		expectedTraceParent string
+		expectedTraceState  string
+	}{
+		{"valid", testTraceParent, testTraceState, testTraceParent, testTraceState},
+		{"valid without tracestate", testTraceParent, "", testTraceParent, ""},
+		{"valid future version with extra field", "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-ext", "", "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-ext", ""},
+		{"no trace context", "", testTraceState, "", ""},
+		{"invalid - uppercase", "00-4BF92F3577B34DA6A3CE929D0E0E4736-00F067AA0BA902B7-01", testTraceState, "", ""},
+		{"invalid - zero trace id", "00-00000000000000000000000000000000-00f067aa0ba902b7-01", testTraceState, "", ""},
+		{"invalid - zero parent id", "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01", testTraceState, "", ""},
+		{"invalid - version ff", "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", testTraceState, "", ""},
+		{"invalid - version 00 with extra field", testTraceParent + "-ext", testTraceState, "", ""},
+		{"invalid - malformed", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7", testTraceState, "", ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), common.CorrelationHeader, "correlation-id") // nolint:staticcheck
+			ctx = context.WithValue(ctx, common.TraceParentHeader, testCase.traceParent)               // nolint:staticcheck
+			ctx = context.WithValue(ctx, common.TraceStateHeader, testCase.traceState)                 // nolint:staticcheck
+
+			var res map[string]string
+			err := GetRequest(ctx, &res, ts.URL, common.ApiPingRoute, nil)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedTraceParent, res[common.TraceParentHeader])
+			assert.Equal(t, testCase.expectedTraceState, res[common.TraceStateHeader])
+			assert.Equal(t, "correlation-id", res[common.CorrelationHeader])
+		})
+	}
+}
+
+func TestWithSpanHooks(t *testing.T) {
+	tests := []struct {
+		name               string
+		statusCode         int
+		expectedStatusCode int
+		expectedErrKind    errors.ErrKind
+	}{
+		{"ok", http.StatusOK, http.StatusOK, ""},
+		{"not found", http.StatusNotFound, http.StatusNotFound, errors.KindEntityDoesNotExist},
+		{"server error", http.StatusInternalServerError, http.StatusInternalServerError, errors.KindServerError},
+		{"service unavailable", 0, 0, errors.KindServiceUnavailable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			ts := newTraceServer(testCase.statusCode)
+			baseUrl := ts.URL
+			if testCase.statusCode == 0 {
+				// No response is received from a closed server
+				ts.Close()
+			} else {
+				defer ts.Close()
+			}
+
+			var started, ended []Span
+			var endTraceParent string
+			hooks := SpanHooks{
+				Start: func(ctx context.Context, span Span) context.Context {
+					started = append(started, span)
+					assert.Equal(t, testTraceParent, FromContext(ctx, common.TraceParentHeader))
+					// Start a child span whose trace context is propagated to the service
+					return context.WithValue(ctx, common.TraceParentHeader, testChildTraceParent) // nolint:staticcheck
+				},
+				End: func(ctx context.Context, span Span) {
+					ended = append(ended, span)
+					endTraceParent = FromContext(ctx, common.TraceParentHeader)
+				},
+			}
+			ctx := context.WithValue(context.Background(), common.TraceParentHeader, testTraceParent) // nolint:staticcheck
+
+			var res map[string]string
+			err := GetRequest(ctx, &res, baseUrl, common.ApiDeviceRoute+"/name/Random-Integer-Device", nil, WithSpanHooks(hooks))
+			if testCase.expectedErrKind == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testChildTraceParent, res[common.TraceParentHeader])
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrKind, errors.Kind(err))
+			}
+
+			expectedSpan := Span{Method: http.MethodGet, Route: common.ApiDeviceByNameRoute}
+			assert.Equal(t, []Span{expectedSpan}, started)
+			expectedSpan.StatusCode = testCase.expectedStatusCode
+			expectedSpan.ErrKind = testCase.expectedErrKind
+			assert.Equal(t, []Span{expectedSpan}, ended)
+			assert.Equal(t, testChildTraceParent, endTraceParent)
+		})
+	}
+}
+
+func TestWithSpanHooksNilHooks(t *testing.T) {
+	ts := newTraceServer(http.StatusOK)
+	defer ts.Close()
+
+	ctx := context.WithValue(context.Background(), common.TraceParentHeader, testTraceParent) // nolint:staticcheck
+	var res map[string]string
+	err := GetRequest(ctx, &res, ts.URL, common.ApiPingRoute, nil, WithSpanHooks(SpanHooks{}))
+	require.NoError(t, err)
+	assert.Equal(t, testTraceParent, res[common.TraceParentHeader])
+}
@@ -0,0 +1,84 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+const (
+	// UnixScheme is the URL scheme of the base URLs addressing a service listening on a unix domain socket, e.g.
+	// unix:///run/edgex/core-data.sock
+	UnixScheme = "unix"
+
+	// unixHostSuffix marks the request hosts which encode a unix domain socket path
+	unixHostSuffix = ".unix"
+	// unixHostHeader is the Host header sent to the services listening on a unix domain socket
+	unixHostHeader = "localhost"
+)
+
+// unixSocketHost encodes the socket path as the host of the request URL, so that the connections to different
+// sockets are pooled separately by the transport and the socket path can be recovered when dialing.
+func unixSocketHost(socketPath string) (string, errors.EdgeX) {
+	if socketPath == "" {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "the unix domain socket path should not be empty", nil)
+	}
+	return hex.EncodeToString([]byte(socketPath)) + unixHostSuffix, nil
+}
+
+// unixSocketPath returns the socket path encoded in a request host or address by unixSocketHost
+func unixSocketPath(addr string) (string, bool) {
+	host := addr
+	if h, _, err := net.SplitHostPort(addr); err == nil {
+		host = h
+	}
+	if !strings.HasSuffix(host, unixHostSuffix) {
+		return "", false
+	}
+	socketPath, err := hex.DecodeString(strings.TrimSuffix(host, unixHostSuffix))
+	if err != nil {
+		return "", false
+	}
+	return string(socketPath), true
+}
+
+// defaultUnixTransport is the unix domain socket capable clone of http.DefaultTransport, which is shared by the
+// requests without TLS configuration so that the connections are reused across requests
+var defaultUnixTransport struct {
+	once      sync.Once
+	transport *http.Transport
+}
+
+// unixTransport returns the unix domain socket capable clone of http.DefaultTransport
+func unixTransport() *http.Transport {
+	defaultUnixTransport.once.Do(func() {
+		defaultUnixTransport.transport = newUnixTransport(http.DefaultTransport.(*http.Transport))
+	})
+	return defaultUnixTransport.transport
+}
+
+// newUnixTransport returns a clone of the base transport which dials the unix domain socket encoded in the request host
+func newUnixTransport(base *http.Transport) *http.Transport {
+	transport := base.Clone()
+	dialer := &net.Dialer{}
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network string, addr string) (net.Conn, error) {
+		socketPath, ok := unixSocketPath(addr)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a unix domain socket address", addr)
+		}
+		return dialer.DialContext(ctx, UnixScheme, socketPath)
+	}
+	return transport
+}
@@ -0,0 +1,123 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// newUnixSocketServer serves on a unix domain socket and replies with the service name, request method, path and query
+func newUnixSocketServer(t *testing.T, socketPath string, serviceName string) {
+	listener, err := net.Listen(UnixScheme, socketPath)
+	require.NoError(t, err)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res := dtoCommon.BaseWithServiceNameResponse{
+			BaseResponse: dtoCommon.NewBaseResponse("", r.Method+" "+r.Host+" "+r.URL.RequestURI(), http.StatusOK),
+			ServiceName:  serviceName,
+		}
+		w.Header().Set(common.ContentType, common.ContentTypeJSON)
+		_ = json.NewEncoder(w).Encode(res)
+	})}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+}
+
+func TestUnixSocketRequests(t *testing.T) {
+	dir := t.TempDir()
+	coreDataSocket := filepath.Join(dir, "core-data.sock")
+	coreMetadataSocket := filepath.Join(dir, "core-metadata.sock")
+	newUnixSocketServer(t, coreDataSocket, common.CoreDataServiceKey)
+	newUnixSocketServer(t, coreMetadataSocket, common.CoreMetaDataServiceKey)
+
+	deviceRoute := path.Join(common.ApiDeviceRoute, common.Name, "device")
+	params := map[string][]string{common.Limit: {"10"}}
+
+	var res dtoCommon.BaseWithServiceNameResponse
+	err := GetRequest(context.Background(), &res, UnixScheme+"://"+coreDataSocket, common.ApiAllEventRoute, params)
+	require.NoError(t, err)
+	assert.Equal(t, common.CoreDataServiceKey, res.ServiceName)
+	assert.Equal(t, "GET localhost "+common.ApiAllEventRoute+"?limit=10", res.Message)
+
+	err = PostRequestWithRawData(context.Background(), &res, UnixScheme+"://"+coreMetadataSocket, common.ApiDeviceRoute, nil, []string{"device"})
+	require.NoError(t, err)
+	assert.Equal(t, common.CoreMetaDataServiceKey, res.ServiceName)
+	assert.Equal(t, "POST localhost "+common.ApiDeviceRoute, res.Message)
+
+	err = DeleteRequest(context.Background(), &res, UnixScheme+"://"+coreMetadataSocket, deviceRoute, WithCompression(common.ContentEncodingGzip, 0))
+	require.NoError(t, err)
+	assert.Equal(t, common.CoreMetaDataServiceKey, res.ServiceName)
+	assert.Equal(t, "DELETE localhost "+deviceRoute, res.Message)
+
+	// The connections of the different sockets must not be mixed up by the connection pool
+	err = GetRequest(context.Background(), &res, UnixScheme+"://"+coreDataSocket, common.ApiPingRoute, nil)
+	require.NoError(t, err)
+	assert.Equal(t, common.CoreDataServiceKey, res.ServiceName)
+}
+
+func TestUnixSocketRequestErrors(t *testing.T) {
+	tests := []struct {
+		name            string
+		baseUrl         string
+		expectedErrKind errors.ErrKind
+	}{
+		{"no socket path", "unix://", errors.KindContractInvalid},
+		{"socket not found", UnixScheme + "://" + filepath.Join(t.TempDir(), "missing.sock"), errors.KindServiceUnavailable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var res dtoCommon.BaseResponse
+			err := GetRequest(context.Background(), &res, testCase.baseUrl, common.ApiPingRoute, nil)
+			require.Error(t, err)
+			assert.Equal(t, testCase.expectedErrKind, errors.Kind(err))
+		})
+	}
+}
+
+func TestUnixTransportReused(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "http://"+socketHost(t, "/run/edgex/core-data.sock")+common.ApiPingRoute, nil)
+	require.NoError(t, err)
+
+	// The requests without TLS configuration share the clone of the default transport
+	first, edgexErr := newClientOptions(nil).httpClient(req)
+	require.NoError(t, edgexErr)
+	second, edgexErr := newClientOptions(nil).httpClient(req)
+	require.NoError(t, edgexErr)
+	assert.Same(t, unixTransport(), first.Transport)
+	assert.Same(t, first.Transport, second.Transport)
+
+	// The requests with a TLS configuration share the clone of its transport, which is held by the option
+	opt := WithTLSConfig(TLSConfig{})
+	first, edgexErr = newClientOptions([]ClientOption{opt}).httpClient(req)
+	require.NoError(t, edgexErr)
+	second, edgexErr = newClientOptions([]ClientOption{opt}).httpClient(req)
+	require.NoError(t, edgexErr)
+	assert.Same(t, first.Transport, second.Transport)
+	assert.NotSame(t, unixTransport(), first.Transport)
+	assert.Equal(t, unixHostHeader, req.Host)
+}
+
+func socketHost(t *testing.T, socketPath string) string {
+	host, err := unixSocketHost(socketPath)
+	require.NoError(t, err)
+	return host
+}
@@ -27,18 +27,34 @@ type DeviceClient interface {
 	AllDevices(ctx context.Context, labels []string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX)
 	// DeviceNameExists checks whether the device exists.
 	DeviceNameExists(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
+	// DeviceIdExists checks whether the device with the specified id exists.
+	DeviceIdExists(ctx context.Context, id string) (common.BaseResponse, errors.EdgeX)
 	// DeviceByName returns a device by device name.
 	DeviceByName(ctx context.Context, name string) (responses.DeviceResponse, errors.EdgeX)
+	// DeviceById returns a device by device id.
+	DeviceById(ctx context.Context, id string) (responses.DeviceResponse, errors.EdgeX)
 	// DeleteByName deletes a device by device name.
 	DeleteDeviceByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
+	// DeleteDeviceById deletes a device by device id.
+	DeleteDeviceById(ctx context.Context, id string) (common.BaseResponse, errors.EdgeX)
 	// DevicesByProfileName returns devices associated with the specified device profile.
 	// The result can be limited in a certain range by specifying the offset and limit parameters.
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	DevicesByProfileName(ctx context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX)
+	// DevicesByProfileId returns devices associated with the device profile of the specified id.
+	// The result can be limited in a certain range by specifying the offset and limit parameters.
+	// offset: The number of items to skip before starting to collect the result set. Default is 0.
+	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
+	DevicesByProfileId(ctx context.Context, id string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX)
 	// DevicesByServiceName returns devices associated with the specified device service.
 	// The result can be limited in a certain range by specifying the offset and limit parameters.
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	DevicesByServiceName(ctx context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX)
+	// DevicesByServiceId returns devices associated with the device service of the specified id.
+	// The result can be limited in a certain range by specifying the offset and limit parameters.
+	// offset: The number of items to skip before starting to collect the result set. Default is 0.
+	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
+	DevicesByServiceId(ctx context.Context, id string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX)
 }
@@ -26,8 +26,12 @@ type DeviceProfileClient interface {
 	UpdateByYaml(ctx context.Context, yamlFilePath string) (common.BaseResponse, errors.EdgeX)
 	// DeleteByName deletes profile by name
 	DeleteByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
+	// DeleteById deletes profile by id
+	DeleteById(ctx context.Context, id string) (common.BaseResponse, errors.EdgeX)
 	// DeviceProfileByName queries profile by name
 	DeviceProfileByName(ctx context.Context, name string) (responses.DeviceProfileResponse, errors.EdgeX)
+	// DeviceProfileById queries profile by id
+	DeviceProfileById(ctx context.Context, id string) (responses.DeviceProfileResponse, errors.EdgeX)
 	// AllDeviceProfiles queries all profiles
 	AllDeviceProfiles(ctx context.Context, labels []string, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX)
 	// DeviceProfilesByModel queries profiles by model
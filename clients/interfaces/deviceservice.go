@@ -22,6 +22,10 @@ type DeviceServiceClient interface {
 	AllDeviceServices(ctx context.Context, labels []string, offset int, limit int) (responses.MultiDeviceServicesResponse, errors.EdgeX)
 	// DeviceServiceByName returns a device service by name.
 	DeviceServiceByName(ctx context.Context, name string) (responses.DeviceServiceResponse, errors.EdgeX)
+	// DeviceServiceById returns a device service by id.
+	DeviceServiceById(ctx context.Context, id string) (responses.DeviceServiceResponse, errors.EdgeX)
 	// DeleteByName deletes a device service by name.
 	DeleteByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
+	// DeleteById deletes a device service by id.
+	DeleteById(ctx context.Context, id string) (common.BaseResponse, errors.EdgeX)
 }
@@ -0,0 +1,30 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// DeviceServiceDiscoveryClient defines the interface for interactions with the discovery and device validation endpoints on the EdgeX Foundry device service.
+type DeviceServiceDiscoveryClient interface {
+	// Discover triggers the discovery of new devices, the RequestId of the response identifying the discovery when the device service provides one
+	Discover(ctx context.Context) (common.BaseResponse, errors.EdgeX)
+	// DiscoveryStatus returns the progress of the discovery with the specified request id.
+	// Device services which cannot report the progress of a discovery fail with a NotFound, NotAllowed or NotImplemented error.
+	DiscoveryStatus(ctx context.Context, requestId string) (responses.DiscoveryStatusResponse, errors.EdgeX)
+	// CancelDiscovery cancels the discovery with the specified request id.
+	// Device services which cannot cancel a discovery fail with a NotFound, NotAllowed or NotImplemented error.
+	CancelDiscovery(ctx context.Context, requestId string) (common.BaseResponse, errors.EdgeX)
+	// ValidateDevice asks the device service whether it accepts the device. A device rejected by the device service is reported by the result
+	// rather than by an error, which is only returned when the validation could not be performed.
+	ValidateDevice(ctx context.Context, request requests.AddDeviceRequest) (responses.DeviceValidationResult, errors.EdgeX)
+}
@@ -0,0 +1,18 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// EndpointResolver defines the interface for resolving the base URL of the EdgeX Foundry service targeted by a client.
+type EndpointResolver interface {
+	// Resolve returns the current base URL of the service, e.g. http://localhost:59880
+	Resolve(ctx context.Context) (string, errors.EdgeX)
+}
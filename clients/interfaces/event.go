@@ -8,6 +8,7 @@ package interfaces
 import (
 	"context"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
@@ -23,6 +24,10 @@ type EventClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	AllEvents(ctx context.Context, offset, limit int) (responses.MultiEventsResponse, errors.EdgeX)
+	// EventById returns an event by id.
+	EventById(ctx context.Context, id string) (responses.EventResponse, errors.EdgeX)
+	// DeleteById deletes an event by id.
+	DeleteById(ctx context.Context, id string) (common.BaseResponse, errors.EdgeX)
 	// EventCount returns a count of all of events currently stored in the database.
 	EventCount(ctx context.Context) (common.CountResponse, errors.EdgeX)
 	// EventCountByDeviceName returns a count of all of events currently stored in the database, sourced from the specified device.
@@ -40,4 +45,10 @@ type EventClient interface {
 	EventsByTimeRange(ctx context.Context, start, end, offset, limit int) (responses.MultiEventsResponse, errors.EdgeX)
 	// DeleteByAge deletes events that are older than the given age. Age is supposed in milliseconds from created timestamp.
 	DeleteByAge(ctx context.Context, age int) (common.BaseResponse, errors.EdgeX)
+	// AllEventsStream behaves like AllEvents, but passes each event to the handler as soon as it is decoded from the
+	// response instead of collecting all of them in memory. Returning an error from the handler stops the decoding.
+	AllEventsStream(ctx context.Context, offset, limit int, handler func(dtos.Event) errors.EdgeX) (common.BaseWithTotalCountResponse, errors.EdgeX)
+	// EventsByDeviceNameStream behaves like EventsByDeviceName, but passes each event to the handler as soon as it is decoded from the
+	// response instead of collecting all of them in memory. Returning an error from the handler stops the decoding.
+	EventsByDeviceNameStream(ctx context.Context, name string, offset, limit int, handler func(dtos.Event) errors.EdgeX) (common.BaseWithTotalCountResponse, errors.EdgeX)
 }
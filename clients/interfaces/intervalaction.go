@@ -27,6 +27,10 @@ type IntervalActionClient interface {
 	AllIntervalActions(ctx context.Context, offset int, limit int) (responses.MultiIntervalActionsResponse, errors.EdgeX)
 	// IntervalActionByName returns a intervalAction by name.
 	IntervalActionByName(ctx context.Context, name string) (responses.IntervalActionResponse, errors.EdgeX)
+	// IntervalActionsByTarget returns intervalActions by target, which is the name of the service the intervalActions are sent to.
+	// offset: The number of items to skip before starting to collect the result set. Default is 0.
+	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
+	IntervalActionsByTarget(ctx context.Context, target string, offset int, limit int) (responses.MultiIntervalActionsResponse, errors.EdgeX)
 	// DeleteIntervalActionByName deletes a intervalAction by name.
 	DeleteIntervalActionByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
 }
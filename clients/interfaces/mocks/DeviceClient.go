@@ -69,6 +69,29 @@ func (_m *DeviceClient) AllDevices(ctx context.Context, labels []string, offset
 	return r0, r1
 }
 
+// DeleteDeviceById provides a mock function with given fields: ctx, id
+func (_m *DeviceClient) DeleteDeviceById(ctx context.Context, id string) (common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	var r0 common.BaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) common.BaseResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(common.BaseResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeleteDeviceByName provides a mock function with given fields: ctx, name
 func (_m *DeviceClient) DeleteDeviceByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name)
@@ -92,6 +115,29 @@ func (_m *DeviceClient) DeleteDeviceByName(ctx context.Context, name string) (co
 	return r0, r1
 }
 
+// DeviceById provides a mock function with given fields: ctx, id
+func (_m *DeviceClient) DeviceById(ctx context.Context, id string) (responses.DeviceResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	var r0 responses.DeviceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) responses.DeviceResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(responses.DeviceResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceByName provides a mock function with given fields: ctx, name
 func (_m *DeviceClient) DeviceByName(ctx context.Context, name string) (responses.DeviceResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name)
@@ -115,6 +161,29 @@ func (_m *DeviceClient) DeviceByName(ctx context.Context, name string) (response
 	return r0, r1
 }
 
+// DeviceIdExists provides a mock function with given fields: ctx, id
+func (_m *DeviceClient) DeviceIdExists(ctx context.Context, id string) (common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	var r0 common.BaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) common.BaseResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(common.BaseResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceNameExists provides a mock function with given fields: ctx, name
 func (_m *DeviceClient) DeviceNameExists(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name)
@@ -138,6 +207,29 @@ func (_m *DeviceClient) DeviceNameExists(ctx context.Context, name string) (comm
 	return r0, r1
 }
 
+// DevicesByProfileId provides a mock function with given fields: ctx, id, offset, limit
+func (_m *DeviceClient) DevicesByProfileId(ctx context.Context, id string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, id, offset, limit)
+
+	var r0 responses.MultiDevicesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) responses.MultiDevicesResponse); ok {
+		r0 = rf(ctx, id, offset, limit)
+	} else {
+		r0 = ret.Get(0).(responses.MultiDevicesResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, id, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DevicesByProfileName provides a mock function with given fields: ctx, name, offset, limit
 func (_m *DeviceClient) DevicesByProfileName(ctx context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name, offset, limit)
@@ -161,6 +253,29 @@ func (_m *DeviceClient) DevicesByProfileName(ctx context.Context, name string, o
 	return r0, r1
 }
 
+// DevicesByServiceId provides a mock function with given fields: ctx, id, offset, limit
+func (_m *DeviceClient) DevicesByServiceId(ctx context.Context, id string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, id, offset, limit)
+
+	var r0 responses.MultiDevicesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) responses.MultiDevicesResponse); ok {
+		r0 = rf(ctx, id, offset, limit)
+	} else {
+		r0 = ret.Get(0).(responses.MultiDevicesResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, id, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DevicesByServiceName provides a mock function with given fields: ctx, name, offset, limit
 func (_m *DeviceClient) DevicesByServiceName(ctx context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name, offset, limit)
@@ -142,6 +142,29 @@ func (_m *DeviceProfileClient) AllDeviceProfiles(ctx context.Context, labels []s
 	return r0, r1
 }
 
+// DeleteById provides a mock function with given fields: ctx, id
+func (_m *DeviceProfileClient) DeleteById(ctx context.Context, id string) (common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	var r0 common.BaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) common.BaseResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(common.BaseResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeleteByName provides a mock function with given fields: ctx, name
 func (_m *DeviceProfileClient) DeleteByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name)
@@ -211,6 +234,29 @@ func (_m *DeviceProfileClient) DeleteDeviceResourceByName(ctx context.Context, p
 	return r0, r1
 }
 
+// DeviceProfileById provides a mock function with given fields: ctx, id
+func (_m *DeviceProfileClient) DeviceProfileById(ctx context.Context, id string) (responses.DeviceProfileResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	var r0 responses.DeviceProfileResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) responses.DeviceProfileResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(responses.DeviceProfileResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceProfileByName provides a mock function with given fields: ctx, name
 func (_m *DeviceProfileClient) DeviceProfileByName(ctx context.Context, name string) (responses.DeviceProfileResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name)
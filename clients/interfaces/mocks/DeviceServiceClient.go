@@ -69,6 +69,29 @@ func (_m *DeviceServiceClient) AllDeviceServices(ctx context.Context, labels []s
 	return r0, r1
 }
 
+// DeleteById provides a mock function with given fields: ctx, id
+func (_m *DeviceServiceClient) DeleteById(ctx context.Context, id string) (common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	var r0 common.BaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) common.BaseResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(common.BaseResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeleteByName provides a mock function with given fields: ctx, name
 func (_m *DeviceServiceClient) DeleteByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name)
@@ -92,6 +115,29 @@ func (_m *DeviceServiceClient) DeleteByName(ctx context.Context, name string) (c
 	return r0, r1
 }
 
+// DeviceServiceById provides a mock function with given fields: ctx, id
+func (_m *DeviceServiceClient) DeviceServiceById(ctx context.Context, id string) (responses.DeviceServiceResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	var r0 responses.DeviceServiceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) responses.DeviceServiceResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(responses.DeviceServiceResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceServiceByName provides a mock function with given fields: ctx, name
 func (_m *DeviceServiceClient) DeviceServiceByName(ctx context.Context, name string) (responses.DeviceServiceResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name)
@@ -0,0 +1,129 @@
+// Code generated by mockery v2.15.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	common "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	errors "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	mock "github.com/stretchr/testify/mock"
+
+	requests "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+
+	responses "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+)
+
+// DeviceServiceDiscoveryClient is an autogenerated mock type for the DeviceServiceDiscoveryClient type
+type DeviceServiceDiscoveryClient struct {
+	mock.Mock
+}
+
+// CancelDiscovery provides a mock function with given fields: ctx, requestId
+func (_m *DeviceServiceDiscoveryClient) CancelDiscovery(ctx context.Context, requestId string) (common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, requestId)
+
+	var r0 common.BaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) common.BaseResponse); ok {
+		r0 = rf(ctx, requestId)
+	} else {
+		r0 = ret.Get(0).(common.BaseResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, requestId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// Discover provides a mock function with given fields: ctx
+func (_m *DeviceServiceDiscoveryClient) Discover(ctx context.Context) (common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx)
+
+	var r0 common.BaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context) common.BaseResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(common.BaseResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context) errors.EdgeX); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DiscoveryStatus provides a mock function with given fields: ctx, requestId
+func (_m *DeviceServiceDiscoveryClient) DiscoveryStatus(ctx context.Context, requestId string) (responses.DiscoveryStatusResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, requestId)
+
+	var r0 responses.DiscoveryStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) responses.DiscoveryStatusResponse); ok {
+		r0 = rf(ctx, requestId)
+	} else {
+		r0 = ret.Get(0).(responses.DiscoveryStatusResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, requestId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ValidateDevice provides a mock function with given fields: ctx, request
+func (_m *DeviceServiceDiscoveryClient) ValidateDevice(ctx context.Context, request requests.AddDeviceRequest) (responses.DeviceValidationResult, errors.EdgeX) {
+	ret := _m.Called(ctx, request)
+
+	var r0 responses.DeviceValidationResult
+	if rf, ok := ret.Get(0).(func(context.Context, requests.AddDeviceRequest) responses.DeviceValidationResult); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Get(0).(responses.DeviceValidationResult)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, requests.AddDeviceRequest) errors.EdgeX); ok {
+		r1 = rf(ctx, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewDeviceServiceDiscoveryClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDeviceServiceDiscoveryClient creates a new instance of DeviceServiceDiscoveryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDeviceServiceDiscoveryClient(t mockConstructorTestingTNewDeviceServiceDiscoveryClient) *DeviceServiceDiscoveryClient {
+	mock := &DeviceServiceDiscoveryClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
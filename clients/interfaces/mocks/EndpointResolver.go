@@ -0,0 +1,54 @@
+// Code generated by mockery v2.15.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	errors "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EndpointResolver is an autogenerated mock type for the EndpointResolver type
+type EndpointResolver struct {
+	mock.Mock
+}
+
+// Resolve provides a mock function with given fields: ctx
+func (_m *EndpointResolver) Resolve(ctx context.Context) (string, errors.EdgeX) {
+	ret := _m.Called(ctx)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context) errors.EdgeX); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewEndpointResolver interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewEndpointResolver creates a new instance of EndpointResolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewEndpointResolver(t mockConstructorTestingTNewEndpointResolver) *EndpointResolver {
+	mock := &EndpointResolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
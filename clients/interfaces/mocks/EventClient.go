@@ -7,6 +7,8 @@ import (
 
 	common "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -67,6 +69,29 @@ func (_m *EventClient) AllEvents(ctx context.Context, offset int, limit int) (re
 	return r0, r1
 }
 
+// AllEventsStream provides a mock function with given fields: ctx, offset, limit, handler
+func (_m *EventClient) AllEventsStream(ctx context.Context, offset int, limit int, handler func(dtos.Event) errors.EdgeX) (common.BaseWithTotalCountResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, offset, limit, handler)
+
+	var r0 common.BaseWithTotalCountResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, func(dtos.Event) errors.EdgeX) common.BaseWithTotalCountResponse); ok {
+		r0 = rf(ctx, offset, limit, handler)
+	} else {
+		r0 = ret.Get(0).(common.BaseWithTotalCountResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, func(dtos.Event) errors.EdgeX) errors.EdgeX); ok {
+		r1 = rf(ctx, offset, limit, handler)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeleteByAge provides a mock function with given fields: ctx, age
 func (_m *EventClient) DeleteByAge(ctx context.Context, age int) (common.BaseResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, age)
@@ -113,6 +138,52 @@ func (_m *EventClient) DeleteByDeviceName(ctx context.Context, name string) (com
 	return r0, r1
 }
 
+// DeleteById provides a mock function with given fields: ctx, id
+func (_m *EventClient) DeleteById(ctx context.Context, id string) (common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	var r0 common.BaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) common.BaseResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(common.BaseResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// EventById provides a mock function with given fields: ctx, id
+func (_m *EventClient) EventById(ctx context.Context, id string) (responses.EventResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	var r0 responses.EventResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) responses.EventResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(responses.EventResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // EventCount provides a mock function with given fields: ctx
 func (_m *EventClient) EventCount(ctx context.Context) (common.CountResponse, errors.EdgeX) {
 	ret := _m.Called(ctx)
@@ -182,6 +253,29 @@ func (_m *EventClient) EventsByDeviceName(ctx context.Context, name string, offs
 	return r0, r1
 }
 
+// EventsByDeviceNameStream provides a mock function with given fields: ctx, name, offset, limit, handler
+func (_m *EventClient) EventsByDeviceNameStream(ctx context.Context, name string, offset int, limit int, handler func(dtos.Event) errors.EdgeX) (common.BaseWithTotalCountResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, name, offset, limit, handler)
+
+	var r0 common.BaseWithTotalCountResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, func(dtos.Event) errors.EdgeX) common.BaseWithTotalCountResponse); ok {
+		r0 = rf(ctx, name, offset, limit, handler)
+	} else {
+		r0 = ret.Get(0).(common.BaseWithTotalCountResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, func(dtos.Event) errors.EdgeX) errors.EdgeX); ok {
+		r1 = rf(ctx, name, offset, limit, handler)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // EventsByTimeRange provides a mock function with given fields: ctx, start, end, offset, limit
 func (_m *EventClient) EventsByTimeRange(ctx context.Context, start int, end int, offset int, limit int) (responses.MultiEventsResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, start, end, offset, limit)
@@ -115,6 +115,29 @@ func (_m *IntervalActionClient) IntervalActionByName(ctx context.Context, name s
 	return r0, r1
 }
 
+// IntervalActionsByTarget provides a mock function with given fields: ctx, target, offset, limit
+func (_m *IntervalActionClient) IntervalActionsByTarget(ctx context.Context, target string, offset int, limit int) (responses.MultiIntervalActionsResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, target, offset, limit)
+
+	var r0 responses.MultiIntervalActionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) responses.MultiIntervalActionsResponse); ok {
+		r0 = rf(ctx, target, offset, limit)
+	} else {
+		r0 = ret.Get(0).(responses.MultiIntervalActionsResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, target, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, reqs
 func (_m *IntervalActionClient) Update(ctx context.Context, reqs []requests.UpdateIntervalActionRequest) ([]common.BaseResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, reqs)
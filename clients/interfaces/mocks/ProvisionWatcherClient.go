@@ -69,6 +69,29 @@ func (_m *ProvisionWatcherClient) AllProvisionWatchers(ctx context.Context, labe
 	return r0, r1
 }
 
+// DeleteProvisionWatcherById provides a mock function with given fields: ctx, id
+func (_m *ProvisionWatcherClient) DeleteProvisionWatcherById(ctx context.Context, id string) (common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	var r0 common.BaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) common.BaseResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(common.BaseResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeleteProvisionWatcherByName provides a mock function with given fields: ctx, name
 func (_m *ProvisionWatcherClient) DeleteProvisionWatcherByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name)
@@ -92,6 +115,29 @@ func (_m *ProvisionWatcherClient) DeleteProvisionWatcherByName(ctx context.Conte
 	return r0, r1
 }
 
+// ProvisionWatcherById provides a mock function with given fields: ctx, id
+func (_m *ProvisionWatcherClient) ProvisionWatcherById(ctx context.Context, id string) (responses.ProvisionWatcherResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	var r0 responses.ProvisionWatcherResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) responses.ProvisionWatcherResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(responses.ProvisionWatcherResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ProvisionWatcherByName provides a mock function with given fields: ctx, name
 func (_m *ProvisionWatcherClient) ProvisionWatcherByName(ctx context.Context, name string) (responses.ProvisionWatcherResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name)
@@ -7,6 +7,8 @@ import (
 
 	common "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -42,6 +44,29 @@ func (_m *ReadingClient) AllReadings(ctx context.Context, offset int, limit int)
 	return r0, r1
 }
 
+// AllReadingsStream provides a mock function with given fields: ctx, offset, limit, handler
+func (_m *ReadingClient) AllReadingsStream(ctx context.Context, offset int, limit int, handler func(dtos.BaseReading) errors.EdgeX) (common.BaseWithTotalCountResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, offset, limit, handler)
+
+	var r0 common.BaseWithTotalCountResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, func(dtos.BaseReading) errors.EdgeX) common.BaseWithTotalCountResponse); ok {
+		r0 = rf(ctx, offset, limit, handler)
+	} else {
+		r0 = ret.Get(0).(common.BaseWithTotalCountResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, func(dtos.BaseReading) errors.EdgeX) errors.EdgeX); ok {
+		r1 = rf(ctx, offset, limit, handler)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingCount provides a mock function with given fields: ctx
 func (_m *ReadingClient) ReadingCount(ctx context.Context) (common.CountResponse, errors.EdgeX) {
 	ret := _m.Called(ctx)
@@ -180,6 +205,52 @@ func (_m *ReadingClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx co
 	return r0, r1
 }
 
+// ReadingsByDeviceNameAndTimeRange provides a mock function with given fields: ctx, name, start, end, offset, limit
+func (_m *ReadingClient) ReadingsByDeviceNameAndTimeRange(ctx context.Context, name string, start int, end int, offset int, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, name, start, end, offset, limit)
+
+	var r0 responses.MultiReadingsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, int, int) responses.MultiReadingsResponse); ok {
+		r0 = rf(ctx, name, start, end, offset, limit)
+	} else {
+		r0 = ret.Get(0).(responses.MultiReadingsResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, name, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ReadingsByDeviceNameStream provides a mock function with given fields: ctx, name, offset, limit, handler
+func (_m *ReadingClient) ReadingsByDeviceNameStream(ctx context.Context, name string, offset int, limit int, handler func(dtos.BaseReading) errors.EdgeX) (common.BaseWithTotalCountResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, name, offset, limit, handler)
+
+	var r0 common.BaseWithTotalCountResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, func(dtos.BaseReading) errors.EdgeX) common.BaseWithTotalCountResponse); ok {
+		r0 = rf(ctx, name, offset, limit, handler)
+	} else {
+		r0 = ret.Get(0).(common.BaseWithTotalCountResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, func(dtos.BaseReading) errors.EdgeX) errors.EdgeX); ok {
+		r1 = rf(ctx, name, offset, limit, handler)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingsByResourceName provides a mock function with given fields: ctx, name, offset, limit
 func (_m *ReadingClient) ReadingsByResourceName(ctx context.Context, name string, offset int, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name, offset, limit)
@@ -0,0 +1,56 @@
+// Code generated by mockery v2.15.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	errors "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	mock "github.com/stretchr/testify/mock"
+
+	responses "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+)
+
+// UnitsOfMeasureClient is an autogenerated mock type for the UnitsOfMeasureClient type
+type UnitsOfMeasureClient struct {
+	mock.Mock
+}
+
+// UnitsOfMeasure provides a mock function with given fields: ctx
+func (_m *UnitsOfMeasureClient) UnitsOfMeasure(ctx context.Context) (responses.UnitsOfMeasureCatalogResponse, errors.EdgeX) {
+	ret := _m.Called(ctx)
+
+	var r0 responses.UnitsOfMeasureCatalogResponse
+	if rf, ok := ret.Get(0).(func(context.Context) responses.UnitsOfMeasureCatalogResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(responses.UnitsOfMeasureCatalogResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context) errors.EdgeX); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewUnitsOfMeasureClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUnitsOfMeasureClient creates a new instance of UnitsOfMeasureClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUnitsOfMeasureClient(t mockConstructorTestingTNewUnitsOfMeasureClient) *UnitsOfMeasureClient {
+	mock := &UnitsOfMeasureClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -27,8 +27,12 @@ type ProvisionWatcherClient interface {
 	AllProvisionWatchers(ctx context.Context, labels []string, offset int, limit int) (responses.MultiProvisionWatchersResponse, errors.EdgeX)
 	// ProvisionWatcherByName returns a provision watcher by name.
 	ProvisionWatcherByName(ctx context.Context, name string) (responses.ProvisionWatcherResponse, errors.EdgeX)
+	// ProvisionWatcherById returns a provision watcher by id.
+	ProvisionWatcherById(ctx context.Context, id string) (responses.ProvisionWatcherResponse, errors.EdgeX)
 	// DeleteProvisionWatcherByName deletes a provision watcher by name.
 	DeleteProvisionWatcherByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
+	// DeleteProvisionWatcherById deletes a provision watcher by id.
+	DeleteProvisionWatcherById(ctx context.Context, id string) (common.BaseResponse, errors.EdgeX)
 	// ProvisionWatchersByProfileName returns provision watchers associated with the specified device profile name.
 	// The result can be limited in a certain range by specifying the offset and limit parameters.
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
@@ -8,6 +8,7 @@ package interfaces
 import (
 	"context"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
@@ -28,6 +29,11 @@ type ReadingClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	ReadingsByDeviceName(ctx context.Context, name string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX)
+	// ReadingsByDeviceNameAndTimeRange returns readings by device name and specified time range. Readings are sorted in descending order of origin time.
+	// start, end: Unix timestamp, indicating the date/time range
+	// offset: The number of items to skip before starting to collect the result set. Default is 0.
+	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
+	ReadingsByDeviceNameAndTimeRange(ctx context.Context, name string, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX)
 	// ReadingsByResourceName returns a portion of the entire readings according to the device resource name, offset and limit parameters. Readings are sorted in descending order of created time.
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
@@ -57,4 +63,10 @@ type ReadingClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx context.Context, deviceName string, resourceNames []string, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX)
+	// AllReadingsStream behaves like AllReadings, but passes each reading to the handler as soon as it is decoded from the
+	// response instead of collecting all of them in memory. Returning an error from the handler stops the decoding.
+	AllReadingsStream(ctx context.Context, offset, limit int, handler func(dtos.BaseReading) errors.EdgeX) (common.BaseWithTotalCountResponse, errors.EdgeX)
+	// ReadingsByDeviceNameStream behaves like ReadingsByDeviceName, but passes each reading to the handler as soon as it is decoded from the
+	// response instead of collecting all of them in memory. Returning an error from the handler stops the decoding.
+	ReadingsByDeviceNameStream(ctx context.Context, name string, offset, limit int, handler func(dtos.BaseReading) errors.EdgeX) (common.BaseWithTotalCountResponse, errors.EdgeX)
 }
@@ -0,0 +1,19 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// UnitsOfMeasureClient defines the interface for interactions with the units of measure endpoint on the EdgeX Foundry core-metadata service.
+type UnitsOfMeasureClient interface {
+	// UnitsOfMeasure returns the catalog of the units of measure accepted by core-metadata
+	UnitsOfMeasure(ctx context.Context) (responses.UnitsOfMeasureCatalogResponse, errors.EdgeX)
+}
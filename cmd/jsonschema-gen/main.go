@@ -0,0 +1,65 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// jsonschema-gen writes the JSON Schema (draft 2020-12) documents of the DTOs edited as files, so that editors validate
+// and complete them, e.g. jsonschema-gen -dto DeviceProfile -o deviceprofile.schema.json, or jsonschema-gen -dir schemas
+// to write every document as <DTO>.schema.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/jsonschema"
+)
+
+func main() {
+	var dto, output, dir string
+	flag.StringVar(&dto, "dto", "", "the DTO whose schema is written, one of "+strings.Join(jsonschema.DocumentNames(), ", "))
+	flag.StringVar(&output, "o", "", "the file the schema is written to, the standard output by default")
+	flag.StringVar(&dir, "dir", "", "the directory every schema is written to instead of a single one")
+	flag.Parse()
+
+	var err error
+	if dir != "" {
+		err = writeAll(dir)
+	} else {
+		err = write(dto, output)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func writeAll(dir string) error {
+	for _, name := range jsonschema.DocumentNames() {
+		if err := write(name, filepath.Join(dir, name+".schema.json")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func write(name string, output string) error {
+	schema, edgexErr := jsonschema.Document(name)
+	if edgexErr != nil {
+		return edgexErr
+	}
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(output, data, 0644)
+}
@@ -0,0 +1,65 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// openapi-gen writes the OpenAPI 3 document of an EdgeX service API generated from the route registry and the DTOs,
+// e.g. openapi-gen -service core-metadata -format yaml -o core-metadata.yaml
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/openapi"
+)
+
+func main() {
+	var service, format, output string
+	flag.StringVar(&service, "service", "", "the key of the service, one of "+strings.Join(openapi.ServiceKeys(), ", "))
+	flag.StringVar(&format, "format", "json", "the format of the document, json or yaml")
+	flag.StringVar(&output, "o", "", "the file the document is written to, the standard output by default")
+	flag.Parse()
+
+	if err := run(service, format, output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(service string, format string, output string) error {
+	doc, edgexErr := openapi.Generate(service)
+	if edgexErr != nil {
+		return edgexErr
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	switch format {
+	case "json":
+		data = append(data, '\n')
+	case "yaml":
+		// JSON being YAML, the document is converted through its generic representation
+		var v interface{}
+		if err = yaml.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		if data, err = yaml.Marshal(v); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown format %s", format)
+	}
+
+	if output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(output, data, 0644)
+}
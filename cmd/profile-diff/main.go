@@ -0,0 +1,72 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// profile-diff compares two versions of a device profile YAML file, e.g. profile-diff -format json old.yaml new.yaml.
+// It exits with status 1 when a change breaks the compatibility or a profile cannot be read.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/profiles"
+)
+
+func main() {
+	var format string
+	flag.StringVar(&format, "format", "text", "the format of the changes, text or json")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [-format text|json] old.yaml new.yaml\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 || (format != "text" && format != "json") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	old, err := readProfile(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	new, err := readProfile(flag.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	diff := profiles.DiffDeviceProfiles(old, new)
+	if format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(diff); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	} else {
+		fmt.Print(diff.String())
+	}
+	if diff.Breaking {
+		os.Exit(1)
+	}
+}
+
+func readProfile(file string) (dtos.DeviceProfile, error) {
+	var profile dtos.DeviceProfile
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return profile, err
+	}
+	if err := yaml.Unmarshal(data, &profile); err != nil {
+		return profile, fmt.Errorf("%s: %w", file, err)
+	}
+	return profile, nil
+}
@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// profile-lint reports the mistakes of device profile YAML files, e.g. profile-lint -format json profiles/*.yaml. It
+// exits with status 1 when a profile holds an error or cannot be read.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/lint"
+)
+
+// fileDiagnostic is a diagnostic of a profile file
+type fileDiagnostic struct {
+	File string `json:"file"`
+	lint.Diagnostic
+}
+
+func main() {
+	var format string
+	flag.StringVar(&format, "format", "text", "the format of the diagnostics, text or json")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [-format text|json] profile.yaml...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 || (format != "text" && format != "json") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	failed := false
+	diagnostics := []fileDiagnostic{}
+	for _, file := range flag.Args() {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			failed = true
+			continue
+		}
+		fileDiagnostics, edgexErr := lint.DeviceProfileYaml(data)
+		if edgexErr != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", file, edgexErr.Error())
+			failed = true
+			continue
+		}
+		failed = failed || lint.HasErrors(fileDiagnostics)
+		for _, d := range fileDiagnostics {
+			diagnostics = append(diagnostics, fileDiagnostic{File: file, Diagnostic: d})
+		}
+	}
+
+	if format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(diagnostics); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	} else {
+		for _, d := range diagnostics {
+			fmt.Printf("%s:%s\n", d.File, d.Diagnostic.String())
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
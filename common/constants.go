@@ -114,6 +114,7 @@ const (
 	ApiWatcherCallbackNameRoute = ApiBase + "/callback/watcher/name/{name}"
 	ApiServiceCallbackRoute     = ApiBase + "/callback/service"
 	ApiDiscoveryRoute           = ApiBase + "/discovery"
+	ApiDiscoveryByIdRoute       = ApiDiscoveryRoute + "/" + RequestId + "/{" + RequestId + "}"
 	ApiDeviceValidationRoute    = ApiBase + "/validate/device"
 
 	ApiIntervalRoute               = ApiBase + "/interval"
@@ -173,6 +174,7 @@ const (
 	Status        = "status"
 	Cleanup       = "cleanup"
 	Sender        = "sender"
+	RequestId     = "requestId"
 	Severity      = "severity"
 	Interval      = "interval"
 
@@ -260,6 +262,8 @@ const (
 const (
 	ClientMonitorDefault = 15000              // Defaults the interval at which a given service client will refresh its endpoint from the Registry, if used
 	CorrelationHeader    = "X-Correlation-ID" // Sets the key of the Correlation ID HTTP header
+	TraceParentHeader    = "traceparent"      // Sets the key of the W3C Trace Context traceparent HTTP header
+	TraceStateHeader     = "tracestate"       // Sets the key of the W3C Trace Context tracestate HTTP header
 )
 
 // Constants related to how services identify themselves in the Service Registry
@@ -293,6 +297,22 @@ const (
 	ContentTypeXML  = "application/xml"
 )
 
+// Constants related to the content encodings supported by the REST clients
+const (
+	AcceptEncoding         = "Accept-Encoding"
+	ContentEncoding        = "Content-Encoding"
+	ContentEncodingGzip    = "gzip"
+	ContentEncodingDeflate = "deflate"
+)
+
+// Constants related to the conditional requests supported by the REST clients
+const (
+	ETag            = "ETag"
+	IfNoneMatch     = "If-None-Match"
+	LastModified    = "Last-Modified"
+	IfModifiedSince = "If-Modified-Since"
+)
+
 // Constants related to System Events
 const (
 	DeviceSystemEventType           = "device"
@@ -25,6 +25,11 @@ var valueTypes = []string{
 	ValueTypeObject,
 }
 
+// ValueTypes returns the value types supported by the device resources, in upper camel case
+func ValueTypes() []string {
+	return append([]string(nil), valueTypes...)
+}
+
 // // NormalizeValueType normalizes the valueType to upper camel case
 func NormalizeValueType(valueType string) (string, error) {
 	for _, v := range valueTypes {
@@ -32,15 +32,16 @@ const (
 )
 
 const (
+	// RFC3986UnreservedCharsRegexString is the pattern of the values accepted by the edgex-dto-rfc3986-unreserved-chars tag.
 	// Per https://tools.ietf.org/html/rfc3986#section-2.3, unreserved characters= ALPHA / DIGIT / "-" / "." / "_" / "~"
 	// Also due to names used in topics for Redis Pub/Sub, "."are not allowed
-	rFC3986UnreservedCharsRegexString = "^[a-zA-Z0-9-_~:;=]+$"
+	RFC3986UnreservedCharsRegexString = "^[a-zA-Z0-9-_~:;=]+$"
 	intervalDatetimeLayout            = "20060102T150405"
 	name                              = "Name"
 )
 
 var (
-	rFC3986UnreservedCharsRegex = regexp.MustCompile(rFC3986UnreservedCharsRegexString)
+	rFC3986UnreservedCharsRegex = regexp.MustCompile(RFC3986UnreservedCharsRegexString)
 )
 
 func init() {
@@ -0,0 +1,102 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package devices
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+)
+
+// DefaultChunkSize is the number of devices added per request when no chunk size is given
+const DefaultChunkSize = 100
+
+// Result is the outcome of the addition of the device of a row, StatusCode being the status returned by core-metadata
+// for the device, or the status of the row error when the device was not sent
+type Result struct {
+	Line       int    `json:"line"`
+	Name       string `json:"name"`
+	Id         string `json:"id,omitempty"`
+	StatusCode int    `json:"statusCode"`
+	Message    string `json:"message,omitempty"`
+}
+
+// Succeeded tells whether the device was added
+func (r Result) Succeeded() bool {
+	return r.StatusCode == http.StatusCreated
+}
+
+// Add adds the valid devices of the rows with requests of at most chunkSize devices, and returns the result of each row
+// in the order of the rows. The failure of a request is reported by the results of all the devices it holds.
+func Add(ctx context.Context, client interfaces.DeviceClient, rows []Row, chunkSize int) []Result {
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	results := make([]Result, len(rows))
+	var pending []int
+	for i, row := range rows {
+		results[i] = Result{Line: row.Line, Name: row.Device.Name}
+		if row.Err != nil {
+			results[i].StatusCode = row.Err.Code()
+			results[i].Message = row.Err.Error()
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	for start := 0; start < len(pending); start += chunkSize {
+		end := start + chunkSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+		addChunk(ctx, client, rows, results, pending[start:end])
+	}
+	return results
+}
+
+// addChunk adds the devices of the rows at the chunk indexes, the responses being mapped back to the rows by request id,
+// or by position when core-metadata does not echo the request ids
+func addChunk(ctx context.Context, client interfaces.DeviceClient, rows []Row, results []Result, chunk []int) {
+	reqs := make([]requests.AddDeviceRequest, len(chunk))
+	byRequestId := make(map[string]int, len(chunk))
+	for i, r := range chunk {
+		reqs[i] = requests.NewAddDeviceRequest(rows[r].Device)
+		byRequestId[reqs[i].RequestId] = r
+	}
+
+	responses, err := client.Add(ctx, reqs)
+	if err != nil {
+		for _, r := range chunk {
+			results[r].StatusCode = err.Code()
+			results[r].Message = err.Error()
+		}
+		return
+	}
+
+	answered := make(map[int]bool, len(chunk))
+	for i, res := range responses {
+		r, ok := byRequestId[res.RequestId]
+		if !ok {
+			if i >= len(chunk) {
+				continue
+			}
+			r = chunk[i]
+		}
+		results[r].Id = res.Id
+		results[r].StatusCode = res.StatusCode
+		results[r].Message = res.Message
+		answered[r] = true
+	}
+	for _, r := range chunk {
+		if !answered[r] {
+			results[r].StatusCode = http.StatusInternalServerError
+			results[r].Message = "no response was returned for the device"
+		}
+	}
+}
@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package devices
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+func testRow(line int, name string) Row {
+	return Row{Line: line, Device: dtos.Device{
+		Name:           name,
+		AdminState:     models.Unlocked,
+		OperatingState: models.Up,
+		ServiceName:    "device-modbus",
+		ProfileName:    "Sensor",
+		Protocols:      map[string]dtos.ProtocolProperties{"modbus-tcp": {"Address": "10.0.0.1"}},
+	}}
+}
+
+// addResponses answers the requests in reverse order, the devices named Existing being reported as conflicting
+func addResponses(_ context.Context, reqs []requests.AddDeviceRequest) []dtoCommon.BaseWithIdResponse {
+	responses := make([]dtoCommon.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		res := dtoCommon.BaseWithIdResponse{BaseResponse: dtoCommon.NewBaseResponse(req.RequestId, "", http.StatusCreated), Id: "id-" + req.Device.Name}
+		if req.Device.Name == "Existing" {
+			res = dtoCommon.BaseWithIdResponse{BaseResponse: dtoCommon.NewBaseResponse(req.RequestId, "device name Existing already exists", http.StatusConflict)}
+		}
+		responses[len(reqs)-1-i] = res
+	}
+	return responses
+}
+
+func TestAdd(t *testing.T) {
+	invalid := testRow(4, "Invalid")
+	invalid.Err = errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid device", nil)
+	rows := []Row{testRow(2, "Sensor-1"), testRow(3, "Existing"), invalid, testRow(5, "Sensor-2")}
+
+	client := &mocks.DeviceClient{}
+	client.On("Add", mock.Anything, mock.Anything).Return(addResponses, nil)
+
+	results := Add(context.Background(), client, rows, 2)
+
+	expected := []Result{
+		{Line: 2, Name: "Sensor-1", Id: "id-Sensor-1", StatusCode: http.StatusCreated},
+		{Line: 3, Name: "Existing", StatusCode: http.StatusConflict, Message: "device name Existing already exists"},
+		{Line: 4, Name: "Invalid", StatusCode: http.StatusBadRequest, Message: "invalid device"},
+		{Line: 5, Name: "Sensor-2", Id: "id-Sensor-2", StatusCode: http.StatusCreated},
+	}
+	assert.Equal(t, expected, results)
+	assert.True(t, results[0].Succeeded())
+	assert.False(t, results[1].Succeeded())
+	client.AssertNumberOfCalls(t, "Add", 2)
+	assert.Len(t, client.Calls[0].Arguments.Get(1), 2)
+	assert.Len(t, client.Calls[1].Arguments.Get(1), 1)
+}
+
+func TestAddRequestError(t *testing.T) {
+	rows := []Row{testRow(2, "Sensor-1"), testRow(3, "Sensor-2"), testRow(4, "Sensor-3")}
+
+	client := &mocks.DeviceClient{}
+	client.On("Add", mock.Anything, mock.MatchedBy(func(reqs []requests.AddDeviceRequest) bool {
+		return len(reqs) == 2
+	})).Return(nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "core-metadata is unavailable", nil))
+	client.On("Add", mock.Anything, mock.Anything).Return([]dtoCommon.BaseWithIdResponse{}, nil)
+
+	results := Add(context.Background(), client, rows, 2)
+
+	expected := []Result{
+		{Line: 2, Name: "Sensor-1", StatusCode: http.StatusServiceUnavailable, Message: "core-metadata is unavailable"},
+		{Line: 3, Name: "Sensor-2", StatusCode: http.StatusServiceUnavailable, Message: "core-metadata is unavailable"},
+		{Line: 4, Name: "Sensor-3", StatusCode: http.StatusInternalServerError, Message: "no response was returned for the device"},
+	}
+	assert.Equal(t, expected, results)
+}
@@ -0,0 +1,357 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package devices
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// The columns of the device CSV. The protocol properties have a column each, named protocols.<protocol>.<property>,
+// the labels and the auto events are lists separated by semicolons, an auto event being written
+// <sourceName>:<interval> or <sourceName>:<interval>:onChange, and the location and the tags are JSON.
+const (
+	ColumnName           = "name"
+	ColumnDescription    = "description"
+	ColumnAdminState     = "adminState"
+	ColumnOperatingState = "operatingState"
+	ColumnServiceName    = "serviceName"
+	ColumnProfileName    = "profileName"
+	ColumnLabels         = "labels"
+	ColumnAutoEvents     = "autoEvents"
+	ColumnLocation       = "location"
+	ColumnTags           = "tags"
+	ProtocolColumnPrefix = "protocols."
+)
+
+const (
+	listSeparator      = ";"
+	autoEventSeparator = ":"
+	onChangeFlag       = "onChange"
+)
+
+var csvColumns = []string{
+	ColumnName, ColumnDescription, ColumnAdminState, ColumnOperatingState, ColumnServiceName, ColumnProfileName,
+	ColumnLabels, ColumnAutoEvents, ColumnLocation, ColumnTags,
+}
+
+// column is a column of the CSV header, protocol and property being set for the protocol property columns
+type column struct {
+	name     string
+	protocol string
+	property string
+}
+
+// ReadCSV reads the devices of a CSV whose first line is the header, each row being validated. An error is returned
+// when the header is invalid or the CSV is malformed, the errors of the rows being reported by the rows.
+func ReadCSV(r io.Reader) ([]Row, errors.EdgeX) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the device CSV has no header", nil)
+	}
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to read the device CSV header", err)
+	}
+	columns, edgexErr := parseHeader(header)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	var rows []Row
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			parseErr, ok := err.(*csv.ParseError)
+			if !ok || parseErr.Err != csv.ErrFieldCount {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to read the device CSV", err)
+			}
+			rows = append(rows, Row{Line: parseErr.StartLine, Err: errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("the row has %d columns instead of %d", len(record), len(columns)), nil)})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		device, edgexErr := parseRecord(columns, record)
+		rows = append(rows, Row{Line: line, Device: device, Err: edgexErr})
+	}
+	checkRows(rows)
+	return rows, nil
+}
+
+// WriteCSV writes the devices as CSV, with a column for each protocol property of the devices. The devices which cannot
+// be read back are rejected with a KindContractInvalid error before anything is written, i.e. a protocol name holding a
+// dot, a protocol without properties or with an empty property, a label holding a semicolon, an empty label, an auto
+// event source name holding a colon or a semicolon, and a value with leading or trailing spaces.
+func WriteCSV(w io.Writer, devices []dtos.Device) errors.EdgeX {
+	var protocolColumns []string
+	seen := make(map[string]bool)
+	for _, device := range devices {
+		if edgexErr := checkCSVDevice(device); edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+		for protocol, properties := range device.Protocols {
+			for property := range properties {
+				name := ProtocolColumnPrefix + protocol + "." + property
+				if !seen[name] {
+					seen[name] = true
+					protocolColumns = append(protocolColumns, name)
+				}
+			}
+		}
+	}
+	sort.Strings(protocolColumns)
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(append(append([]string(nil), csvColumns...), protocolColumns...)); err != nil {
+		return errors.NewCommonEdgeX(errors.KindIOError, "failed to write the device CSV header", err)
+	}
+	for _, device := range devices {
+		record, edgexErr := formatRecord(device, protocolColumns)
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+		if err := writer.Write(record); err != nil {
+			return errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("failed to write the device %s", device.Name), err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindIOError, "failed to write the device CSV", err)
+	}
+	return nil
+}
+
+func parseHeader(header []string) ([]column, errors.EdgeX) {
+	known := make(map[string]bool, len(csvColumns))
+	for _, name := range csvColumns {
+		known[name] = true
+	}
+
+	columns := make([]column, len(header))
+	seen := make(map[string]bool, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if seen[name] {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the device CSV column %s is duplicated", name), nil)
+		}
+		seen[name] = true
+
+		if strings.HasPrefix(name, ProtocolColumnPrefix) {
+			parts := strings.SplitN(strings.TrimPrefix(name, ProtocolColumnPrefix), ".", 2)
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the device CSV column %s is not named protocols.<protocol>.<property>", name), nil)
+			}
+			columns[i] = column{name: name, protocol: parts[0], property: parts[1]}
+			continue
+		}
+		if !known[name] {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the device CSV column %s is unknown", name), nil)
+		}
+		columns[i] = column{name: name}
+	}
+	if !seen[ColumnName] {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the device CSV has no %s column", ColumnName), nil)
+	}
+	return columns, nil
+}
+
+func parseRecord(columns []column, record []string) (dtos.Device, errors.EdgeX) {
+	var device dtos.Device
+	for i, c := range columns {
+		value := strings.TrimSpace(record[i])
+		if c.protocol != "" {
+			if value == "" {
+				continue
+			}
+			if device.Protocols == nil {
+				device.Protocols = make(map[string]dtos.ProtocolProperties)
+			}
+			if device.Protocols[c.protocol] == nil {
+				device.Protocols[c.protocol] = make(dtos.ProtocolProperties)
+			}
+			device.Protocols[c.protocol][c.property] = value
+			continue
+		}
+
+		switch c.name {
+		case ColumnName:
+			device.Name = value
+		case ColumnDescription:
+			device.Description = value
+		case ColumnAdminState:
+			device.AdminState = value
+		case ColumnOperatingState:
+			device.OperatingState = value
+		case ColumnServiceName:
+			device.ServiceName = value
+		case ColumnProfileName:
+			device.ProfileName = value
+		case ColumnLabels:
+			device.Labels = splitList(value)
+		case ColumnAutoEvents:
+			autoEvents, err := parseAutoEvents(value)
+			if err != nil {
+				return device, errors.NewCommonEdgeXWrapper(err)
+			}
+			device.AutoEvents = autoEvents
+		case ColumnLocation:
+			if value == "" {
+				continue
+			}
+			if err := json.Unmarshal([]byte(value), &device.Location); err != nil {
+				return device, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the %s column is not JSON", ColumnLocation), err)
+			}
+		case ColumnTags:
+			if value == "" {
+				continue
+			}
+			if err := json.Unmarshal([]byte(value), &device.Tags); err != nil {
+				return device, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the %s column is not a JSON object", ColumnTags), err)
+			}
+		}
+	}
+	return device, nil
+}
+
+// checkCSVDevice checks that the device is read back as written: its names and lists hold no separator of the CSV
+// columns, its values have no leading or trailing spaces, which are trimmed when reading, and its protocols have
+// properties, none of them empty as an empty cell is read as a missing property
+func checkCSVDevice(device dtos.Device) errors.EdgeX {
+	fields := []struct {
+		column string
+		value  string
+	}{
+		{ColumnName, device.Name},
+		{ColumnDescription, device.Description},
+		{ColumnAdminState, device.AdminState},
+		{ColumnOperatingState, device.OperatingState},
+		{ColumnServiceName, device.ServiceName},
+		{ColumnProfileName, device.ProfileName},
+	}
+	for _, field := range fields {
+		if hasSurroundingSpaces(field.value) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("the %s '%s' of the device %s has leading or trailing spaces", field.column, field.value, device.Name), nil)
+		}
+	}
+	for protocol, properties := range device.Protocols {
+		if strings.Contains(protocol, ".") {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("the protocol %s of the device %s holds a dot, which separates the protocol from the property in the CSV columns", protocol, device.Name), nil)
+		}
+		if len(properties) == 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("the protocol %s of the device %s has no property, so it has no CSV column", protocol, device.Name), nil)
+		}
+		for property, value := range properties {
+			if value == "" {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid,
+					fmt.Sprintf("the protocol property %s.%s of the device %s is empty, which is read as a missing property", protocol, property, device.Name), nil)
+			}
+			if hasSurroundingSpaces(value) {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid,
+					fmt.Sprintf("the protocol property %s.%s '%s' of the device %s has leading or trailing spaces", protocol, property, value, device.Name), nil)
+			}
+		}
+	}
+	for _, label := range device.Labels {
+		if strings.Contains(label, listSeparator) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("the label %s of the device %s holds the %s list separator", label, device.Name, listSeparator), nil)
+		}
+		if label == "" || hasSurroundingSpaces(label) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("the label '%s' of the device %s is empty or has leading or trailing spaces", label, device.Name), nil)
+		}
+	}
+	for _, a := range device.AutoEvents {
+		if strings.Contains(a.SourceName, autoEventSeparator) || strings.Contains(a.SourceName, listSeparator) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("the auto event source name %s of the device %s holds the %s or %s separator", a.SourceName, device.Name, autoEventSeparator, listSeparator), nil)
+		}
+		if hasSurroundingSpaces(a.SourceName) || hasSurroundingSpaces(a.Interval) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("the auto event '%s' of the device %s has leading or trailing spaces", formatAutoEvents([]dtos.AutoEvent{a}), device.Name), nil)
+		}
+	}
+	return nil
+}
+
+func hasSurroundingSpaces(value string) bool {
+	return strings.TrimSpace(value) != value
+}
+
+func formatRecord(device dtos.Device, protocolColumns []string) ([]string, errors.EdgeX) {
+	location, tags := "", ""
+	if device.Location != nil {
+		data, err := json.Marshal(device.Location)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode the location of the device %s", device.Name), err)
+		}
+		location = string(data)
+	}
+	if len(device.Tags) > 0 {
+		data, err := json.Marshal(device.Tags)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode the tags of the device %s", device.Name), err)
+		}
+		tags = string(data)
+	}
+
+	record := []string{
+		device.Name, device.Description, device.AdminState, device.OperatingState, device.ServiceName, device.ProfileName,
+		strings.Join(device.Labels, listSeparator), formatAutoEvents(device.AutoEvents), location, tags,
+	}
+	for _, name := range protocolColumns {
+		parts := strings.SplitN(strings.TrimPrefix(name, ProtocolColumnPrefix), ".", 2)
+		record = append(record, device.Protocols[parts[0]][parts[1]])
+	}
+	return record, nil
+}
+
+func parseAutoEvents(value string) ([]dtos.AutoEvent, errors.EdgeX) {
+	var autoEvents []dtos.AutoEvent
+	for _, item := range splitList(value) {
+		parts := strings.Split(item, autoEventSeparator)
+		if len(parts) < 2 || len(parts) > 3 || (len(parts) == 3 && parts[2] != onChangeFlag) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("the auto event '%s' is not written <sourceName>:<interval> or <sourceName>:<interval>:%s", item, onChangeFlag), nil)
+		}
+		autoEvents = append(autoEvents, dtos.AutoEvent{SourceName: parts[0], Interval: parts[1], OnChange: len(parts) == 3})
+	}
+	return autoEvents, nil
+}
+
+func formatAutoEvents(autoEvents []dtos.AutoEvent) string {
+	items := make([]string, len(autoEvents))
+	for i, a := range autoEvents {
+		items[i] = a.SourceName + autoEventSeparator + a.Interval
+		if a.OnChange {
+			items[i] += autoEventSeparator + onChangeFlag
+		}
+	}
+	return strings.Join(items, listSeparator)
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, listSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,198 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package devices
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+const testDevicesCSV = `name,serviceName,profileName,labels,autoEvents,tags,protocols.modbus-tcp.Address,protocols.modbus-tcp.Port
+Sensor-1,device-modbus,Sensor,"floor-1; outdoor",Temperature:10s;Status:1m:onChange,"{""site"":""A""}",10.0.0.1,502
+Sensor-2,device-modbus,Sensor,,,,10.0.0.2,
+Sensor-3,device-modbus,,,,,10.0.0.3,502
+Sensor-4,device-modbus,Sensor,,Temperature,,10.0.0.4,502
+Sensor-1,device-modbus,Sensor,,,,10.0.0.5,502
+Sensor-6,device-modbus
+`
+
+func testDevices() []dtos.Device {
+	return []dtos.Device{
+		{
+			Name:           "Sensor-1",
+			AdminState:     models.Unlocked,
+			OperatingState: models.Up,
+			ServiceName:    "device-modbus",
+			ProfileName:    "Sensor",
+			Labels:         []string{"floor-1", "outdoor"},
+			AutoEvents: []dtos.AutoEvent{
+				{SourceName: "Temperature", Interval: "10s"},
+				{SourceName: "Status", Interval: "1m", OnChange: true},
+			},
+			Protocols: map[string]dtos.ProtocolProperties{"modbus-tcp": {"Address": "10.0.0.1", "Port": "502"}},
+			Tags:      map[string]any{"site": "A"},
+		},
+		{
+			Name:           "Sensor-2",
+			AdminState:     models.Unlocked,
+			OperatingState: models.Up,
+			ServiceName:    "device-modbus",
+			ProfileName:    "Sensor",
+			Protocols:      map[string]dtos.ProtocolProperties{"modbus-tcp": {"Address": "10.0.0.2"}},
+		},
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	rows, err := ReadCSV(strings.NewReader(testDevicesCSV))
+	require.NoError(t, err)
+	require.Len(t, rows, 6)
+
+	assert.Equal(t, testDevices(), Devices(rows))
+	tests := []struct {
+		name          string
+		row           Row
+		expectedLine  int
+		expectedKind  errors.ErrKind
+		expectedError string
+	}{
+		{"valid", rows[0], 2, "", ""},
+		{"missing protocol property", rows[1], 3, "", ""},
+		{"missing profile", rows[2], 4, errors.KindContractInvalid, "ProfileName"},
+		{"invalid auto event", rows[3], 5, errors.KindContractInvalid, "auto event 'Temperature'"},
+		{"duplicate name", rows[4], 6, errors.KindDuplicateName, "device Sensor-1 is already defined on line 2"},
+		{"missing columns", rows[5], 7, errors.KindContractInvalid, "the row has 2 columns instead of 8"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedLine, testCase.row.Line)
+			if testCase.expectedError == "" {
+				assert.NoError(t, testCase.row.Err)
+				return
+			}
+			require.Error(t, testCase.row.Err)
+			assert.Equal(t, testCase.expectedKind, errors.Kind(testCase.row.Err))
+			assert.Contains(t, testCase.row.Err.Error(), testCase.expectedError)
+		})
+	}
+}
+
+func TestReadCSVHeaderError(t *testing.T) {
+	tests := []struct {
+		name          string
+		csv           string
+		expectedError string
+	}{
+		{"empty", "", "the device CSV has no header"},
+		{"unknown column", "name,color\n", "the device CSV column color is unknown"},
+		{"duplicate column", "name,name\n", "the device CSV column name is duplicated"},
+		{"invalid protocol column", "name,protocols.modbus\n", "the device CSV column protocols.modbus is not named protocols.<protocol>.<property>"},
+		{"no name column", "serviceName\n", "the device CSV has no name column"},
+		{"malformed", "name\n\"Sensor-1\n", "failed to read the device CSV"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := ReadCSV(strings.NewReader(testCase.csv))
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			assert.Contains(t, err.Error(), testCase.expectedError)
+		})
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, testDevices()))
+
+	expected := `name,description,adminState,operatingState,serviceName,profileName,labels,autoEvents,location,tags,protocols.modbus-tcp.Address,protocols.modbus-tcp.Port
+Sensor-1,,UNLOCKED,UP,device-modbus,Sensor,floor-1;outdoor,Temperature:10s;Status:1m:onChange,,"{""site"":""A""}",10.0.0.1,502
+Sensor-2,,UNLOCKED,UP,device-modbus,Sensor,,,,,10.0.0.2,
+`
+	assert.Equal(t, expected, buf.String())
+
+	rows, err := ReadCSV(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, testDevices(), Devices(rows))
+}
+
+func TestWriteCSVRoundTrip(t *testing.T) {
+	device := testDevices()[1]
+	device.Labels = []string{"floor:1", "site.A"}
+	device.AutoEvents = []dtos.AutoEvent{{SourceName: "Temperature.Celsius", Interval: "10s"}}
+	device.Description = "outdoor sensor, north side"
+	device.Protocols = map[string]dtos.ProtocolProperties{"modbus-tcp": {"Address": "10.0.0.2", "Unit.Id": "1;2"}, "opc-ua": {"Endpoint": "opc.tcp://10.0.0.2:4840"}}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, []dtos.Device{device}))
+	rows, err := ReadCSV(&buf)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.NoError(t, rows[0].Err)
+	assert.Equal(t, device, rows[0].Device)
+}
+
+func TestWriteCSVError(t *testing.T) {
+	tests := []struct {
+		name          string
+		update        func(device *dtos.Device)
+		expectedError string
+	}{
+		{"protocol name with a dot", func(device *dtos.Device) {
+			device.Protocols = map[string]dtos.ProtocolProperties{"modbus.tcp": {"Address": "10.0.0.2"}}
+		}, "the protocol modbus.tcp of the device Sensor-2 holds a dot"},
+		{"label with a semicolon", func(device *dtos.Device) {
+			device.Labels = []string{"floor-1;outdoor"}
+		}, "the label floor-1;outdoor of the device Sensor-2 holds the ; list separator"},
+		{"source name with a colon", func(device *dtos.Device) {
+			device.AutoEvents = []dtos.AutoEvent{{SourceName: "Temperature:Celsius", Interval: "10s"}}
+		}, "the auto event source name Temperature:Celsius of the device Sensor-2"},
+		{"source name with a semicolon", func(device *dtos.Device) {
+			device.AutoEvents = []dtos.AutoEvent{{SourceName: "Temperature;Celsius", Interval: "10s"}}
+		}, "the auto event source name Temperature;Celsius of the device Sensor-2"},
+		{"description with surrounding spaces", func(device *dtos.Device) {
+			device.Description = " outdoor sensor "
+		}, "the description ' outdoor sensor ' of the device Sensor-2 has leading or trailing spaces"},
+		{"protocol property with surrounding spaces", func(device *dtos.Device) {
+			device.Protocols = map[string]dtos.ProtocolProperties{"modbus-tcp": {"Address": "10.0.0.2 "}}
+		}, "the protocol property modbus-tcp.Address '10.0.0.2 ' of the device Sensor-2 has leading or trailing spaces"},
+		{"empty protocol property", func(device *dtos.Device) {
+			device.Protocols = map[string]dtos.ProtocolProperties{"modbus-tcp": {"Address": "10.0.0.2", "Port": ""}}
+		}, "the protocol property modbus-tcp.Port of the device Sensor-2 is empty"},
+		{"protocol without properties", func(device *dtos.Device) {
+			device.Protocols = map[string]dtos.ProtocolProperties{"modbus-tcp": {}}
+		}, "the protocol modbus-tcp of the device Sensor-2 has no property"},
+		{"empty label", func(device *dtos.Device) {
+			device.Labels = []string{"floor-1", ""}
+		}, "the label '' of the device Sensor-2 is empty or has leading or trailing spaces"},
+		{"label with surrounding spaces", func(device *dtos.Device) {
+			device.Labels = []string{" floor-1"}
+		}, "the label ' floor-1' of the device Sensor-2 is empty or has leading or trailing spaces"},
+		{"auto event with surrounding spaces", func(device *dtos.Device) {
+			device.AutoEvents = []dtos.AutoEvent{{SourceName: "Temperature", Interval: "10s "}}
+		}, "the auto event 'Temperature:10s ' of the device Sensor-2 has leading or trailing spaces"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			devices := testDevices()
+			testCase.update(&devices[1])
+
+			var buf bytes.Buffer
+			err := WriteCSV(&buf, devices)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			assert.Contains(t, err.Error(), testCase.expectedError)
+			assert.Empty(t, buf.String(), "nothing should be written")
+		})
+	}
+}
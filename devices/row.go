@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package devices imports and exports device lists in CSV and multi-document YAML, e.g. to onboard the devices of a
+// spreadsheet, and adds the imported devices to core-metadata in chunks.
+package devices
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// Row is a device read from an import file. Line is the line of the row in the CSV or of the document in the YAML, and
+// Err the reason why the device cannot be added, nil when it is valid.
+type Row struct {
+	Line   int
+	Device dtos.Device
+	Err    errors.EdgeX
+}
+
+// Devices returns the valid devices of the rows
+func Devices(rows []Row) []dtos.Device {
+	var devices []dtos.Device
+	for _, row := range rows {
+		if row.Err == nil {
+			devices = append(devices, row.Device)
+		}
+	}
+	return devices
+}
+
+// checkRows validates the devices of the rows which were read without error, the admin and operating states left empty
+// defaulting to UNLOCKED and UP. A device named as a previous one is reported as duplicated.
+func checkRows(rows []Row) {
+	lines := make(map[string]int, len(rows))
+	for i := range rows {
+		row := &rows[i]
+		if row.Err != nil {
+			continue
+		}
+		if row.Device.AdminState == "" {
+			row.Device.AdminState = models.Unlocked
+		}
+		if row.Device.OperatingState == "" {
+			row.Device.OperatingState = models.Up
+		}
+		if err := common.Validate(row.Device); err != nil {
+			row.Err = errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid device", err)
+			continue
+		}
+		if line, ok := lines[row.Device.Name]; ok {
+			row.Err = errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device %s is already defined on line %d", row.Device.Name, line), nil)
+			continue
+		}
+		lines[row.Device.Name] = row.Line
+	}
+}
@@ -0,0 +1,132 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package devices
+
+import (
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// yamlDevice is a device document, the fields being named as in the JSON of the devices. The ids, the timestamps and
+// the connection times are left out as they are assigned by core-metadata.
+type yamlDevice struct {
+	Name           string                       `yaml:"name"`
+	Description    string                       `yaml:"description,omitempty"`
+	AdminState     string                       `yaml:"adminState,omitempty"`
+	OperatingState string                       `yaml:"operatingState,omitempty"`
+	ServiceName    string                       `yaml:"serviceName"`
+	ProfileName    string                       `yaml:"profileName"`
+	Labels         []string                     `yaml:"labels,omitempty,flow"`
+	Location       interface{}                  `yaml:"location,omitempty"`
+	AutoEvents     []yamlAutoEvent              `yaml:"autoEvents,omitempty"`
+	Protocols      map[string]map[string]string `yaml:"protocols"`
+	Tags           map[string]any               `yaml:"tags,omitempty"`
+}
+
+type yamlAutoEvent struct {
+	SourceName string `yaml:"sourceName"`
+	Interval   string `yaml:"interval"`
+	OnChange   bool   `yaml:"onChange,omitempty"`
+}
+
+// ReadYAML reads the devices of a multi-document YAML, one device per document, each device being validated. An error
+// is returned when the YAML is malformed, the errors of the devices being reported by the rows.
+func ReadYAML(r io.Reader) ([]Row, errors.EdgeX) {
+	var rows []Row
+	decoder := yaml.NewDecoder(r)
+	for {
+		var document yaml.Node
+		err := decoder.Decode(&document)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the device YAML", err)
+		}
+		if len(document.Content) == 0 {
+			continue
+		}
+
+		row := Row{Line: document.Content[0].Line}
+		var d yamlDevice
+		if err := document.Decode(&d); err != nil {
+			row.Err = errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the device", err)
+		} else {
+			row.Device = toDevice(d)
+		}
+		rows = append(rows, row)
+	}
+	checkRows(rows)
+	return rows, nil
+}
+
+// WriteYAML writes the devices as a multi-document YAML, one device per document
+func WriteYAML(w io.Writer, devices []dtos.Device) errors.EdgeX {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	for _, device := range devices {
+		if err := encoder.Encode(fromDevice(device)); err != nil {
+			return errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("failed to write the device %s", device.Name), err)
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindIOError, "failed to write the device YAML", err)
+	}
+	return nil
+}
+
+func toDevice(d yamlDevice) dtos.Device {
+	device := dtos.Device{
+		Name:           d.Name,
+		Description:    d.Description,
+		AdminState:     d.AdminState,
+		OperatingState: d.OperatingState,
+		ServiceName:    d.ServiceName,
+		ProfileName:    d.ProfileName,
+		Labels:         d.Labels,
+		Location:       d.Location,
+		Tags:           d.Tags,
+	}
+	for _, a := range d.AutoEvents {
+		device.AutoEvents = append(device.AutoEvents, dtos.AutoEvent{SourceName: a.SourceName, Interval: a.Interval, OnChange: a.OnChange})
+	}
+	if d.Protocols != nil {
+		device.Protocols = make(map[string]dtos.ProtocolProperties, len(d.Protocols))
+		for protocol, properties := range d.Protocols {
+			device.Protocols[protocol] = properties
+		}
+	}
+	return device
+}
+
+func fromDevice(device dtos.Device) yamlDevice {
+	d := yamlDevice{
+		Name:           device.Name,
+		Description:    device.Description,
+		AdminState:     device.AdminState,
+		OperatingState: device.OperatingState,
+		ServiceName:    device.ServiceName,
+		ProfileName:    device.ProfileName,
+		Labels:         device.Labels,
+		Location:       device.Location,
+		Tags:           device.Tags,
+	}
+	for _, a := range device.AutoEvents {
+		d.AutoEvents = append(d.AutoEvents, yamlAutoEvent{SourceName: a.SourceName, Interval: a.Interval, OnChange: a.OnChange})
+	}
+	if device.Protocols != nil {
+		d.Protocols = make(map[string]map[string]string, len(device.Protocols))
+		for protocol, properties := range device.Protocols {
+			d.Protocols[protocol] = properties
+		}
+	}
+	return d
+}
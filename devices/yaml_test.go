@@ -0,0 +1,112 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package devices
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+const testDevicesYAML = `name: Sensor-1
+serviceName: device-modbus
+profileName: Sensor
+labels: [floor-1, outdoor]
+autoEvents:
+  - sourceName: Temperature
+    interval: 10s
+  - sourceName: Status
+    interval: 1m
+    onChange: true
+protocols:
+  modbus-tcp:
+    Address: 10.0.0.1
+    Port: 502
+tags:
+  site: A
+---
+name: Sensor-2
+serviceName: device-modbus
+profileName: Sensor
+protocols:
+  modbus-tcp:
+    Address: 10.0.0.2
+---
+name: Sensor-3
+serviceName: device-modbus
+profileName: Sensor
+protocols: [modbus-tcp]
+---
+name: Sensor-4
+serviceName: device-modbus
+profileName: Sensor
+adminState: ENABLED
+protocols:
+  modbus-tcp:
+    Address: 10.0.0.4
+`
+
+func TestReadYAML(t *testing.T) {
+	rows, err := ReadYAML(strings.NewReader(testDevicesYAML))
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+
+	assert.Equal(t, testDevices(), Devices(rows))
+	assert.Equal(t, []int{1, 18, 25, 30}, []int{rows[0].Line, rows[1].Line, rows[2].Line, rows[3].Line})
+	require.Error(t, rows[2].Err)
+	assert.Contains(t, rows[2].Err.Error(), "failed to decode the device")
+	require.Error(t, rows[3].Err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(rows[3].Err))
+	assert.Contains(t, rows[3].Err.Error(), "AdminState")
+
+	_, err = ReadYAML(strings.NewReader("name: [Sensor-1\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse the device YAML")
+}
+
+func TestWriteYAML(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteYAML(&buf, testDevices()))
+
+	expected := `name: Sensor-1
+adminState: UNLOCKED
+operatingState: UP
+serviceName: device-modbus
+profileName: Sensor
+labels: [floor-1, outdoor]
+autoEvents:
+  - sourceName: Temperature
+    interval: 10s
+  - sourceName: Status
+    interval: 1m
+    onChange: true
+protocols:
+  modbus-tcp:
+    Address: 10.0.0.1
+    Port: "502"
+tags:
+  site: A
+---
+name: Sensor-2
+adminState: UNLOCKED
+operatingState: UP
+serviceName: device-modbus
+profileName: Sensor
+protocols:
+  modbus-tcp:
+    Address: 10.0.0.2
+`
+	assert.Equal(t, expected, buf.String())
+
+	rows, err := ReadYAML(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, testDevices(), Devices(rows))
+}
@@ -0,0 +1,42 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+// DiscoveryStatusResponse reports the progress of a device discovery started on a device service, the RequestId of the
+// BaseResponse identifying the discovery request
+type DiscoveryStatusResponse struct {
+	common.BaseResponse   `json:",inline"`
+	Progress              int `json:"progress"`
+	DiscoveredDeviceCount int `json:"discoveredDeviceCount"`
+}
+
+func NewDiscoveryStatusResponse(requestId string, message string, statusCode int, progress int, discoveredDeviceCount int) DiscoveryStatusResponse {
+	return DiscoveryStatusResponse{
+		BaseResponse:          common.NewBaseResponse(requestId, message, statusCode),
+		Progress:              progress,
+		DiscoveredDeviceCount: discoveredDeviceCount,
+	}
+}
+
+// Completed tells whether the discovery completed, its progress reaching 100
+func (r DiscoveryStatusResponse) Completed() bool {
+	return r.Progress >= 100
+}
+
+// Failed tells whether the discovery failed, its progress being -1
+func (r DiscoveryStatusResponse) Failed() bool {
+	return r.Progress < 0
+}
+
+// DeviceValidationResult is the outcome of validating a device with the device service owning it. A device rejected by
+// the device service is not valid and the Message explains why.
+type DeviceValidationResult struct {
+	RequestId string `json:"requestId"`
+	Valid     bool   `json:"valid"`
+	Message   string `json:"message,omitempty"`
+}
@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+)
+
+func TestNewDiscoveryStatusResponse(t *testing.T) {
+	expectedRequestId := "d61c96fc-f33d-4294-951e-6c2488b42737"
+	expectedStatusCode := 200
+	expectedMessage := "unit test message"
+	actual := NewDiscoveryStatusResponse(expectedRequestId, expectedMessage, expectedStatusCode, 50, 3)
+
+	assert.Equal(t, expectedRequestId, actual.RequestId)
+	assert.Equal(t, expectedStatusCode, actual.StatusCode)
+	assert.Equal(t, expectedMessage, actual.Message)
+	assert.Equal(t, 50, actual.Progress)
+	assert.Equal(t, 3, actual.DiscoveredDeviceCount)
+	assert.False(t, actual.Completed())
+	assert.False(t, actual.Failed())
+
+	assert.True(t, NewDiscoveryStatusResponse(expectedRequestId, "", expectedStatusCode, 100, 3).Completed())
+	assert.True(t, NewDiscoveryStatusResponse(expectedRequestId, "", expectedStatusCode, -1, 0).Failed())
+}
+
+func TestDiscoveryStatusResponse_Marshal(t *testing.T) {
+	status := NewDiscoveryStatusResponse("d61c96fc-f33d-4294-951e-6c2488b42737", "", 200, 50, 3)
+	data, err := json.Marshal(status)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"apiVersion":"`+common.ApiVersion+`","requestId":"d61c96fc-f33d-4294-951e-6c2488b42737","statusCode":200,"progress":50,"discoveredDeviceCount":3}`, string(data))
+
+	var actual DiscoveryStatusResponse
+	require.NoError(t, json.Unmarshal(data, &actual))
+	assert.Equal(t, status, actual)
+}
+
+func TestDeviceValidationResult_Marshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   DeviceValidationResult
+		expected string
+	}{
+		{"valid", DeviceValidationResult{RequestId: "d61c96fc-f33d-4294-951e-6c2488b42737", Valid: true},
+			`{"requestId":"d61c96fc-f33d-4294-951e-6c2488b42737","valid":true}`},
+		{"invalid", DeviceValidationResult{RequestId: "d61c96fc-f33d-4294-951e-6c2488b42737", Message: "unknown protocol"},
+			`{"requestId":"d61c96fc-f33d-4294-951e-6c2488b42737","valid":false,"message":"unknown protocol"}`},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			data, err := json.Marshal(testCase.result)
+			require.NoError(t, err)
+			assert.JSONEq(t, testCase.expected, string(data))
+
+			var actual DeviceValidationResult
+			require.NoError(t, json.Unmarshal(data, &actual))
+			assert.Equal(t, testCase.result, actual)
+		})
+	}
+}
@@ -5,7 +5,10 @@
 
 package responses
 
-import "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+)
 
 type UnitsOfMeasureResponse struct {
 	common.BaseResponse `json:",inline"`
@@ -18,3 +21,16 @@ func NewUnitsOfMeasureResponse(requestId string, message string, statusCode int,
 		Uom:          uom,
 	}
 }
+
+// UnitsOfMeasureCatalogResponse is the UnitsOfMeasureResponse decoded by the clients, its catalog being typed
+type UnitsOfMeasureCatalogResponse struct {
+	common.BaseResponse `json:",inline"`
+	Uom                 dtos.UnitsOfMeasure `json:"uom"`
+}
+
+func NewUnitsOfMeasureCatalogResponse(requestId string, message string, statusCode int, uom dtos.UnitsOfMeasure) UnitsOfMeasureCatalogResponse {
+	return UnitsOfMeasureCatalogResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Uom:          uom,
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 )
 
 func TestNewUnitsOfMeasureResponse(t *testing.T) {
@@ -23,3 +25,16 @@ func TestNewUnitsOfMeasureResponse(t *testing.T) {
 	assert.Equal(t, expectedMessage, actual.Message)
 	assert.Equal(t, expectedUoM, actual.Uom)
 }
+
+func TestNewUnitsOfMeasureCatalogResponse(t *testing.T) {
+	expectedRequestId := "d61c96fc-f33d-4294-951e-6c2488b42737"
+	expectedStatusCode := 200
+	expectedMessage := "unit test message"
+	expectedUoM := dtos.UnitsOfMeasure{Units: map[string]dtos.UnitGroup{"temperature": {Values: []string{"C"}}}}
+	actual := NewUnitsOfMeasureCatalogResponse(expectedRequestId, expectedMessage, expectedStatusCode, expectedUoM)
+
+	assert.Equal(t, expectedRequestId, actual.RequestId)
+	assert.Equal(t, expectedStatusCode, actual.StatusCode)
+	assert.Equal(t, expectedMessage, actual.Message)
+	assert.Equal(t, expectedUoM, actual.Uom)
+}
@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// UnitsOfMeasure is the catalog of the units of measure accepted by core-metadata, the units being grouped by category,
+// e.g. temperature or weights
+type UnitsOfMeasure struct {
+	Source string               `json:"source,omitempty" yaml:"Source,omitempty"`
+	Units  map[string]UnitGroup `json:"units,omitempty" yaml:"Units,omitempty"`
+}
+
+// UnitGroup holds the units of a category of the UnitsOfMeasure catalog
+type UnitGroup struct {
+	Source string   `json:"source,omitempty" yaml:"Source,omitempty"`
+	Values []string `json:"values,omitempty" yaml:"Values,omitempty"`
+}
+
+// Contains tells whether the unit is in the catalog
+func (u UnitsOfMeasure) Contains(unit string) bool {
+	_, ok := u.Category(unit)
+	return ok
+}
+
+// Category returns the category of the unit and true, or false if the unit is not in the catalog
+func (u UnitsOfMeasure) Category(unit string) (string, bool) {
+	for category, group := range u.Units {
+		for _, value := range group.Values {
+			if value == unit {
+				return category, true
+			}
+		}
+	}
+	return "", false
+}
+
+// UnknownProfileUnits returns the units of the device resources of the profile which are not in the catalog, by
+// resource name. The resources without units are ignored.
+func (u UnitsOfMeasure) UnknownProfileUnits(profile DeviceProfile) map[string]string {
+	unknown := make(map[string]string)
+	for _, resource := range profile.DeviceResources {
+		if units := resource.Properties.Units; units != "" && !u.Contains(units) {
+			unknown[resource.Name] = units
+		}
+	}
+	return unknown
+}
+
+// UnknownReadingUnits returns the units of the readings which are not in the catalog, by resource name. The readings
+// without units are ignored.
+func (u UnitsOfMeasure) UnknownReadingUnits(readings []BaseReading) map[string]string {
+	unknown := make(map[string]string)
+	for _, reading := range readings {
+		if reading.Units != "" && !u.Contains(reading.Units) {
+			unknown[reading.ResourceName] = reading.Units
+		}
+	}
+	return unknown
+}
+
+// ValidateProfileUnits returns a ContractInvalid error listing the device resources of the profile whose units are not
+// in the catalog, or nil if all the units are known
+func (u UnitsOfMeasure) ValidateProfileUnits(profile DeviceProfile) errors.EdgeX {
+	unknown := u.UnknownProfileUnits(profile)
+	if len(unknown) == 0 {
+		return nil
+	}
+	return errors.NewCommonEdgeX(errors.KindContractInvalid,
+		fmt.Sprintf("device profile %s uses units which are not in the units of measure catalog: %s", profile.Name, describeUnknownUnits(unknown)), nil)
+}
+
+// ValidateReadingUnits returns a ContractInvalid error listing the resources of the readings whose units are not in the
+// catalog, or nil if all the units are known
+func (u UnitsOfMeasure) ValidateReadingUnits(readings []BaseReading) errors.EdgeX {
+	unknown := u.UnknownReadingUnits(readings)
+	if len(unknown) == 0 {
+		return nil
+	}
+	return errors.NewCommonEdgeX(errors.KindContractInvalid,
+		fmt.Sprintf("readings use units which are not in the units of measure catalog: %s", describeUnknownUnits(unknown)), nil)
+}
+
+// describeUnknownUnits lists the unknown units by resource name, e.g. "humidity: pct, temperature: celsius"
+func describeUnknownUnits(unknown map[string]string) string {
+	resourceNames := make([]string, 0, len(unknown))
+	for resourceName := range unknown {
+		resourceNames = append(resourceNames, resourceName)
+	}
+	sort.Strings(resourceNames)
+	descriptions := make([]string, len(resourceNames))
+	for i, resourceName := range resourceNames {
+		descriptions[i] = fmt.Sprintf("%s: %s", resourceName, unknown[resourceName])
+	}
+	return strings.Join(descriptions, ", ")
+}
@@ -0,0 +1,77 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+var testUnitsOfMeasure = UnitsOfMeasure{
+	Source: "reference to source for all UoM if not specified below",
+	Units: map[string]UnitGroup{
+		"temperature": {Source: "www.weather.com", Values: []string{"C", "F", "K"}},
+		"weights":     {Values: []string{"lbs", "kg"}},
+	},
+}
+
+func TestUnitsOfMeasureUnmarshalJSON(t *testing.T) {
+	data := []byte(`{"source":"edgex","units":{"temperature":{"source":"www.weather.com","values":["C","F","K"]}}}`)
+	var uom UnitsOfMeasure
+	require.NoError(t, json.Unmarshal(data, &uom))
+	assert.Equal(t, "edgex", uom.Source)
+	assert.Equal(t, UnitGroup{Source: "www.weather.com", Values: []string{"C", "F", "K"}}, uom.Units["temperature"])
+}
+
+func TestUnitsOfMeasureCategory(t *testing.T) {
+	category, ok := testUnitsOfMeasure.Category("kg")
+	assert.True(t, ok)
+	assert.Equal(t, "weights", category)
+	assert.True(t, testUnitsOfMeasure.Contains("F"))
+	assert.False(t, testUnitsOfMeasure.Contains("celsius"))
+	assert.False(t, UnitsOfMeasure{}.Contains("C"))
+}
+
+func TestUnitsOfMeasureValidateProfileUnits(t *testing.T) {
+	profile := DeviceProfile{
+		DeviceProfileBasicInfo: DeviceProfileBasicInfo{Name: TestDeviceProfileName},
+		DeviceResources: []DeviceResource{
+			{Name: "temperature", Properties: ResourceProperties{Units: "C"}},
+			{Name: "humidity", Properties: ResourceProperties{Units: "pct"}},
+			{Name: "weight", Properties: ResourceProperties{Units: "stone"}},
+			{Name: "status"},
+		},
+	}
+
+	assert.Equal(t, map[string]string{"humidity": "pct", "weight": "stone"}, testUnitsOfMeasure.UnknownProfileUnits(profile))
+	err := testUnitsOfMeasure.ValidateProfileUnits(profile)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.Contains(t, err.Error(), "humidity: pct, weight: stone")
+
+	profile.DeviceResources = profile.DeviceResources[:1]
+	assert.NoError(t, testUnitsOfMeasure.ValidateProfileUnits(profile))
+}
+
+func TestUnitsOfMeasureValidateReadingUnits(t *testing.T) {
+	readings := []BaseReading{
+		{ResourceName: "temperature", Units: "K"},
+		{ResourceName: "humidity", Units: "pct"},
+		{ResourceName: "status"},
+	}
+
+	assert.Equal(t, map[string]string{"humidity": "pct"}, testUnitsOfMeasure.UnknownReadingUnits(readings))
+	err := testUnitsOfMeasure.ValidateReadingUnits(readings)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.Contains(t, err.Error(), "humidity: pct")
+	assert.NoError(t, testUnitsOfMeasure.ValidateReadingUnits(readings[:1]))
+}
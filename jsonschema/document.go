@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+const (
+	// Draft identifies the JSON Schema dialect of the documents
+	Draft = "https://json-schema.org/draft/2020-12/schema"
+	// DefsRefPrefix references the definitions held by the $defs of a document
+	DefsRefPrefix = "#/$defs/"
+)
+
+// documents lists the DTOs whose schema is exported for editing their YAML or JSON files, e.g. the device profiles
+var documents = map[string]interface{}{
+	"DeviceProfile":                 dtos.DeviceProfile{},
+	"Device":                        dtos.Device{},
+	"ProvisionWatcher":              dtos.ProvisionWatcher{},
+	"DeviceProfileRequest":          requests.DeviceProfileRequest{},
+	"AddDeviceRequest":              requests.AddDeviceRequest{},
+	"UpdateDeviceRequest":           requests.UpdateDeviceRequest{},
+	"AddProvisionWatcherRequest":    requests.AddProvisionWatcherRequest{},
+	"UpdateProvisionWatcherRequest": requests.UpdateProvisionWatcherRequest{},
+}
+
+// DocumentNames returns the names of the DTOs whose schema document can be exported, sorted
+func DocumentNames() []string {
+	names := make([]string, 0, len(documents))
+	for name := range documents {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Document returns the schema document of the named DTO, e.g. DeviceProfile
+func Document(name string) (*Schema, errors.EdgeX) {
+	v, ok := documents[name]
+	if !ok {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no schema document for %s", name), nil)
+	}
+	s := Generate(v)
+	s.Title = name
+	return s, nil
+}
+
+// Generate returns the schema document of the type of the value, referencing the definitions held by its $defs, which
+// include the known enumerations
+func Generate(v interface{}) *Schema {
+	r := NewReflector(DefsRefPrefix)
+	r.NameEnums = true
+	s := r.Reflect(v)
+	s.Schema = Draft
+	s.Defs = r.Definitions
+	return s
+}
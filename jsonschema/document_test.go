@@ -0,0 +1,91 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+func TestDocument(t *testing.T) {
+	for _, name := range DocumentNames() {
+		t.Run(name, func(t *testing.T) {
+			s, err := Document(name)
+			require.NoError(t, err)
+			assert.Equal(t, Draft, s.Schema)
+			assert.Equal(t, DefsRefPrefix+name, s.Ref)
+			assert.Contains(t, s.Defs, name)
+
+			// The references point to the definitions
+			data, jsonErr := json.Marshal(s)
+			require.NoError(t, jsonErr)
+			for _, ref := range strings.Split(string(data), `"$ref":"`)[1:] {
+				assert.Contains(t, s.Defs, strings.TrimPrefix(ref[:strings.Index(ref, `"`)], DefsRefPrefix))
+			}
+		})
+	}
+}
+
+func TestDocumentUnknown(t *testing.T) {
+	_, err := Document("Unknown")
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestDocumentDeviceProfile(t *testing.T) {
+	s, err := Document("DeviceProfile")
+	require.NoError(t, err)
+
+	profile := s.Defs["DeviceProfile"]
+	require.NotNil(t, profile)
+	assert.Equal(t, []string{"name"}, profile.Required)
+	assert.Equal(t, DefsRefPrefix+"DeviceResource", profile.Properties["deviceResources"].Items.Ref)
+
+	properties := s.Defs["ResourceProperties"]
+	require.NotNil(t, properties)
+	assert.Equal(t, DefsRefPrefix+"ValueType", properties.Properties["valueType"].Ref)
+	assert.Equal(t, DefsRefPrefix+"ReadWrite", properties.Properties["readWrite"].Ref)
+
+	valueType := s.Defs["ValueType"]
+	require.NotNil(t, valueType)
+	assert.Equal(t, valueTypePattern, valueType.Pattern)
+	assert.Empty(t, valueType.Enum)
+	assert.Empty(t, properties.Properties["valueType"].Pattern)
+	assert.Equal(t, []interface{}{common.ReadWrite_R, common.ReadWrite_W, common.ReadWrite_RW, common.ReadWrite_WR}, s.Defs["ReadWrite"].Enum)
+}
+
+func TestGenerateEnums(t *testing.T) {
+	tests := []struct {
+		name     string
+		dto      interface{}
+		property string
+		enum     string
+		values   []interface{}
+	}{
+		{"admin state", dtos.Device{}, "adminState", "AdminState", []interface{}{models.Locked, models.Unlocked}},
+		{"admin state of update", dtos.UpdateDevice{}, "adminState", "AdminState", []interface{}{models.Locked, models.Unlocked}},
+		{"severity", dtos.Notification{}, "severity", "Severity", []interface{}{models.Minor, models.Normal, models.Critical}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := Generate(testCase.dto)
+			definition := s.Defs[strings.TrimPrefix(s.Ref, DefsRefPrefix)]
+			require.NotNil(t, definition)
+			assert.Equal(t, DefsRefPrefix+testCase.enum, definition.Properties[testCase.property].Ref)
+			require.Contains(t, s.Defs, testCase.enum)
+			assert.Equal(t, testCase.values, s.Defs[testCase.enum].Enum)
+		})
+	}
+}
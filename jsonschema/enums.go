@@ -0,0 +1,124 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// enum is an enumeration defined once and referenced by the schemas whose validate tag accepts exactly its values. An
+// enumeration whose values are compared case-insensitively also has a pattern, which its definition holds instead of
+// the values.
+type enum struct {
+	name    string
+	values  []string
+	pattern string
+}
+
+// valueTypePattern matches the value types accepted by the edgex-dto-value-type tag, which compares them
+// case-insensitively. The letters are matched by character classes as the ECMA-262 patterns of JSON Schema have no
+// case-insensitive flag.
+var valueTypePattern = caseInsensitivePattern(common.ValueTypes())
+
+var enums = []enum{
+	{name: "ValueType", values: common.ValueTypes(), pattern: valueTypePattern},
+	{name: "ReadWrite", values: []string{common.ReadWrite_R, common.ReadWrite_W, common.ReadWrite_RW, common.ReadWrite_WR}},
+	{name: "AdminState", values: []string{models.Locked, models.Unlocked}},
+	{name: "Severity", values: []string{models.Minor, models.Normal, models.Critical}},
+}
+
+// matches tells whether the schema holds the pattern of the enumeration, or the same values in its enum keyword in any
+// order
+func (e enum) matches(s *Schema) bool {
+	if e.pattern != "" && s.Pattern == e.pattern {
+		return true
+	}
+	values := s.Enum
+	if len(values) == 0 || len(values) != len(e.values) {
+		return false
+	}
+	actual := make([]string, 0, len(values))
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			return false
+		}
+		actual = append(actual, s)
+	}
+	expected := append([]string(nil), e.values...)
+	sort.Strings(actual)
+	sort.Strings(expected)
+	for i := range expected {
+		if actual[i] != expected[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (e enum) schema() *Schema {
+	s := &Schema{Title: e.name, Type: TypeString, Pattern: e.pattern}
+	if e.pattern != "" {
+		return s
+	}
+	for _, v := range e.values {
+		s.Enum = append(s.Enum, v)
+	}
+	return s
+}
+
+// nameEnums replaces the enum and pattern keywords of the schema and its subschemas holding a known enumeration by a
+// reference to the definition of the enumeration
+func (r *Reflector) nameEnums(s *Schema) {
+	if s == nil {
+		return
+	}
+	for _, e := range enums {
+		if !e.matches(s) {
+			continue
+		}
+		if _, ok := r.Definitions[e.name]; !ok {
+			r.Definitions[e.name] = e.schema()
+		}
+		s.Enum = nil
+		s.Pattern = ""
+		s.Ref = r.RefPrefix + e.name
+		break
+	}
+	r.nameEnums(s.Items)
+	r.nameEnums(s.AdditionalProperties)
+	r.nameEnums(s.PropertyNames)
+	for _, sub := range s.AnyOf {
+		r.nameEnums(sub)
+	}
+	for _, sub := range s.AllOf {
+		r.nameEnums(sub)
+	}
+}
+
+// caseInsensitivePattern returns the pattern matching any of the values regardless of the case of their letters
+func caseInsensitivePattern(values []string) string {
+	alternatives := make([]string, len(values))
+	for i, value := range values {
+		var b strings.Builder
+		for _, c := range value {
+			lower, upper := unicode.ToLower(c), unicode.ToUpper(c)
+			if lower == upper {
+				b.WriteString(regexp.QuoteMeta(string(c)))
+				continue
+			}
+			b.WriteString("[" + string(upper) + string(lower) + "]")
+		}
+		alternatives[i] = b.String()
+	}
+	return "^(" + strings.Join(alternatives, "|") + ")$"
+}
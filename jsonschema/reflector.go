@@ -0,0 +1,141 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// Reflector builds the schemas of Go types. The named structs are described once in the Definitions and referenced by
+// RefPrefix followed by their definition name, e.g. #/$defs/Device or #/components/schemas/Device.
+// When NameEnums is set, the known enumerations, e.g. AdminState, are also described once and referenced.
+type Reflector struct {
+	RefPrefix   string
+	Definitions map[string]*Schema
+	NameEnums   bool
+	names       map[reflect.Type]string
+}
+
+// NewReflector creates a Reflector referencing the definitions with the given prefix
+func NewReflector(refPrefix string) *Reflector {
+	return &Reflector{
+		RefPrefix:   refPrefix,
+		Definitions: make(map[string]*Schema),
+		names:       make(map[reflect.Type]string),
+	}
+}
+
+// Reflect returns the schema of the type of the value, which is a reference when the value is a named struct
+func (r *Reflector) Reflect(v interface{}) *Schema {
+	return r.ReflectType(reflect.TypeOf(v))
+}
+
+// ReflectType returns the schema of the type, which is a reference when the type is a named struct
+func (r *Reflector) ReflectType(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+	t = indirect(t)
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: TypeBoolean}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: TypeInteger, Format: "int32"}
+	case reflect.Int, reflect.Int64:
+		return &Schema{Type: TypeInteger, Format: "int64"}
+	case reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: TypeInteger, Format: "int32", Minimum: floatPtr(0)}
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: TypeInteger, Format: "int64", Minimum: floatPtr(0)}
+	case reflect.Float32:
+		return &Schema{Type: TypeNumber, Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: TypeNumber, Format: "double"}
+	case reflect.String:
+		return &Schema{Type: TypeString}
+	case reflect.Slice, reflect.Array:
+		// encoding/json encodes the byte slices as base64 strings
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: TypeString, ContentEncoding: "base64"}
+		}
+		return &Schema{Type: TypeArray, Items: r.ReflectType(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: TypeObject, AdditionalProperties: r.ReflectType(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return r.structSchema(t)
+		}
+		return &Schema{Ref: r.RefPrefix + r.define(t)}
+	}
+	// interface{} and any other type accepts any value
+	return &Schema{}
+}
+
+// define adds the definition of the named struct if not yet defined and returns its name
+func (r *Reflector) define(t reflect.Type) string {
+	if name, ok := r.names[t]; ok {
+		return name
+	}
+	name := t.Name()
+	if _, taken := r.Definitions[name]; taken {
+		pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+		name = string(unicode.ToUpper(rune(pkg[0]))) + pkg[1:] + name
+	}
+	r.names[t] = name
+	// Register the definition before building it, so that a recursive type references itself
+	definition := &Schema{}
+	r.Definitions[name] = definition
+	*definition = *r.structSchema(t)
+	return name
+}
+
+func (r *Reflector) structSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: TypeObject, Properties: make(map[string]*Schema)}
+	r.addFields(s, t, true)
+	return s
+}
+
+// addFields adds the properties encoded by encoding/json for the fields of the struct, the fields of the embedded
+// structs without a json name being promoted to the struct. The fields are not validated when their embedding struct
+// is skipped by validate:"-".
+func (r *Reflector) addFields(s *Schema, t reflect.Type, validate bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" && indirect(field.Type).Kind() == reflect.Struct {
+			r.addFields(s, indirect(field.Type), validate && field.Tag.Get("validate") != "-")
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		property := r.ReflectType(field.Type)
+		if validate && applyValidation(property, field.Type, field.Tag.Get("validate")) {
+			s.Required = append(s.Required, name)
+		}
+		if r.NameEnums {
+			r.nameEnums(property)
+		}
+		s.Properties[name] = property
+	}
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
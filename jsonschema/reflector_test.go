@@ -0,0 +1,207 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+)
+
+type testEmbedded struct {
+	Kind string `json:"kind" validate:"required"`
+}
+
+type testSkipped struct {
+	Value string `json:"value" validate:"required"`
+}
+
+type testNode struct {
+	testEmbedded `json:",inline"`
+	testSkipped  `json:",inline" validate:"-"`
+	Name         string            `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Id           *string           `json:"id" validate:"required_without=Name,edgex-dto-uuid"`
+	State        string            `json:"state,omitempty" validate:"omitempty,oneof='LOCKED' 'UNLOCKED'"`
+	Interval     string            `json:"interval" validate:"required,edgex-dto-duration"`
+	Labels       []string          `json:"labels" validate:"gt=0,dive,required"`
+	Properties   map[string]string `json:"properties" validate:"omitempty,gt=0,dive,keys,required,endkeys,required"`
+	Count        int               `json:"count" validate:"gt=1,lte=10"`
+	Payload      []byte            `json:"payload" validate:"gt=0"`
+	RequestId    string            `json:"requestId" validate:"len=0|uuid"`
+	Children     []testNode        `json:"children"`
+	Any          interface{}       `json:"any"`
+	Ignored      string            `json:"-"`
+	unexported   string
+}
+
+func TestReflectType(t *testing.T) {
+	r := NewReflector("#/$defs/")
+	s := r.Reflect(testNode{})
+	assert.Equal(t, "#/$defs/testNode", s.Ref)
+	require.Contains(t, r.Definitions, "testNode")
+	node := r.Definitions["testNode"]
+
+	assert.Equal(t, TypeObject, node.Type)
+	assert.Equal(t, []string{"kind", "name", "interval"}, node.Required)
+	assert.NotContains(t, node.Properties, "Ignored")
+	assert.NotContains(t, node.Properties, "unexported")
+	assert.Equal(t, "#/$defs/testNode", node.Properties["children"].Items.Ref, "recursive type")
+	assert.Equal(t, &Schema{}, node.Properties["any"])
+
+	tests := []struct {
+		name     string
+		property string
+		expected *Schema
+	}{
+		{"embedded struct promoted", "kind", &Schema{Type: TypeString, MinLength: intPtr(1)}},
+		{"embedded struct not validated", "value", &Schema{Type: TypeString}},
+		{"name patterns", "name", &Schema{Type: TypeString, MinLength: intPtr(1), Pattern: common.RFC3986UnreservedCharsRegexString}},
+		{"uuid pointer", "id", &Schema{Type: TypeString, Format: "uuid"}},
+		{"omitempty enum", "state", &Schema{Type: TypeString, AnyOf: []*Schema{{MaxLength: intPtr(0)}, {Enum: []interface{}{"LOCKED", "UNLOCKED"}}}}},
+		{"duration", "interval", &Schema{Type: TypeString, MinLength: intPtr(1), Pattern: durationPattern}},
+		{"dive into items", "labels", &Schema{Type: TypeArray, MinItems: intPtr(1), Items: &Schema{Type: TypeString, MinLength: intPtr(1)}}},
+		{"dive into keys and values", "properties", &Schema{
+			Type:                 TypeObject,
+			PropertyNames:        &Schema{MinLength: intPtr(1)},
+			AdditionalProperties: &Schema{Type: TypeString, MinLength: intPtr(1)},
+		}},
+		{"number bounds", "count", &Schema{Type: TypeInteger, Format: "int64", ExclusiveMinimum: floatPtr(1), Maximum: floatPtr(10)}},
+		{"byte slice", "payload", &Schema{Type: TypeString, ContentEncoding: "base64", MinLength: intPtr(1)}},
+		{"alternatives", "requestId", &Schema{Type: TypeString, AnyOf: []*Schema{{MinLength: intPtr(0), MaxLength: intPtr(0)}, {Format: "uuid"}}}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Contains(t, node.Properties, testCase.property)
+			assert.Equal(t, testCase.expected, node.Properties[testCase.property])
+		})
+	}
+}
+
+func TestReflectDTO(t *testing.T) {
+	r := NewReflector("#/components/schemas/")
+	s := r.Reflect([]requests.AddDeviceRequest{})
+	assert.Equal(t, TypeArray, s.Type)
+	assert.Equal(t, "#/components/schemas/AddDeviceRequest", s.Items.Ref)
+
+	require.Contains(t, r.Definitions, "Device")
+	device := r.Definitions["Device"]
+	assert.Equal(t, []string{"name", "serviceName", "profileName", "protocols"}, device.Required)
+	assert.Equal(t, []interface{}{"LOCKED", "UNLOCKED"}, device.Properties["adminState"].Enum)
+	assert.Equal(t, intPtr(1), device.Properties["protocols"].MinProperties)
+
+	request := r.Definitions["AddDeviceRequest"]
+	assert.Contains(t, request.Properties, "apiVersion", "BaseRequest fields promoted")
+	assert.Contains(t, request.Required, "apiVersion")
+
+	_, err := json.Marshal(r.Definitions)
+	require.NoError(t, err)
+}
+
+type testEnums struct {
+	State      string   `json:"state,omitempty" validate:"omitempty,oneof='LOCKED' 'UNLOCKED'"`
+	Reordered  string   `json:"reordered" validate:"oneof=UNLOCKED LOCKED"`
+	Subset     string   `json:"subset" validate:"oneof=LOCKED"`
+	Unknown    string   `json:"unknown" validate:"oneof=UP DOWN"`
+	ValueType  string   `json:"valueType" validate:"required,edgex-dto-value-type"`
+	Severities []string `json:"severities" validate:"dive,oneof=MINOR NORMAL CRITICAL"`
+}
+
+func TestReflectNameEnums(t *testing.T) {
+	r := NewReflector("#/$defs/")
+	r.NameEnums = true
+	r.Reflect(testEnums{})
+	require.Contains(t, r.Definitions, "testEnums")
+	properties := r.Definitions["testEnums"].Properties
+
+	tests := []struct {
+		name     string
+		property string
+		expected *Schema
+	}{
+		{"omitempty oneof matching an enum", "state", &Schema{Type: TypeString, AnyOf: []*Schema{{MaxLength: intPtr(0)}, {Ref: "#/$defs/AdminState"}}}},
+		{"oneof matching an enum in another order", "reordered", &Schema{Type: TypeString, Ref: "#/$defs/AdminState"}},
+		{"oneof of a subset of an enum", "subset", &Schema{Type: TypeString, Enum: []interface{}{"LOCKED"}}},
+		{"oneof matching no enum", "unknown", &Schema{Type: TypeString, Enum: []interface{}{"UP", "DOWN"}}},
+		{"value type", "valueType", &Schema{Type: TypeString, MinLength: intPtr(1), Ref: "#/$defs/ValueType"}},
+		{"oneof of the items", "severities", &Schema{Type: TypeArray, Items: &Schema{Type: TypeString, Ref: "#/$defs/Severity"}}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, properties[testCase.property])
+		})
+	}
+
+	assert.Equal(t, &Schema{Title: "AdminState", Type: TypeString, Enum: []interface{}{"LOCKED", "UNLOCKED"}}, r.Definitions["AdminState"])
+	assert.Equal(t, &Schema{Title: "ValueType", Type: TypeString, Pattern: valueTypePattern}, r.Definitions["ValueType"])
+	assert.Equal(t, &Schema{Title: "Severity", Type: TypeString, Enum: []interface{}{"MINOR", "NORMAL", "CRITICAL"}}, r.Definitions["Severity"])
+	assert.NotContains(t, r.Definitions, "ReadWrite", "only the enumerations in use are defined")
+}
+
+func TestReflectWithoutNameEnums(t *testing.T) {
+	r := NewReflector("#/$defs/")
+	r.Reflect(testEnums{})
+	properties := r.Definitions["testEnums"].Properties
+
+	assert.Equal(t, &Schema{Type: TypeString, Enum: []interface{}{"UNLOCKED", "LOCKED"}}, properties["reordered"])
+	assert.Equal(t, &Schema{Type: TypeString, MinLength: intPtr(1), Pattern: valueTypePattern}, properties["valueType"])
+	assert.Equal(t, []string{"testEnums"}, keys(r.Definitions))
+}
+
+func keys(definitions map[string]*Schema) []string {
+	result := make([]string, 0, len(definitions))
+	for name := range definitions {
+		result = append(result, name)
+	}
+	return result
+}
+
+func TestApplyValidationValueType(t *testing.T) {
+	valueType := &Schema{Type: TypeString}
+	assert.True(t, applyValidation(valueType, reflect.TypeOf(""), "required,edgex-dto-value-type"))
+	assert.Equal(t, valueTypePattern, valueType.Pattern)
+	assert.Empty(t, valueType.Enum)
+
+	pattern := regexp.MustCompile(valueType.Pattern)
+	tests := []struct {
+		valueType string
+		expected  bool
+	}{
+		{common.ValueTypeFloat32, true},
+		{common.ValueTypeInt8Array, true},
+		{"float32", true},
+		{"int8array", true},
+		{"BOOL", true},
+		{"Float", false},
+		{"Int8Array2", false},
+		{"", false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.valueType, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, pattern.MatchString(testCase.valueType))
+			// The pattern agrees with the validator, which normalizes the value types
+			_, err := common.NormalizeValueType(testCase.valueType)
+			assert.Equal(t, testCase.expected, err == nil)
+		})
+	}
+}
+
+func TestReflectNameCollision(t *testing.T) {
+	type BaseResponse struct {
+		Message string `json:"message"`
+	}
+	r := NewReflector("#/$defs/")
+	assert.Equal(t, "#/$defs/BaseResponse", r.Reflect(dtoCommon.BaseResponse{}).Ref)
+	assert.Equal(t, "#/$defs/JsonschemaBaseResponse", r.Reflect(BaseResponse{}).Ref)
+	assert.Equal(t, "#/$defs/BaseResponse", r.Reflect(dtoCommon.BaseResponse{}).Ref)
+}
@@ -0,0 +1,118 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package jsonschema reflects over the DTOs to describe them as JSON Schema (draft 2020-12), translating the json tags
+// into properties and the validate tags into schema constraints. The schemas are shared by the OpenAPI document
+// generation, OpenAPI 3.1 being aligned with the draft 2020-12.
+package jsonschema
+
+import "reflect"
+
+// The JSON Schema types of the values
+const (
+	TypeArray   = "array"
+	TypeBoolean = "boolean"
+	TypeInteger = "integer"
+	TypeNumber  = "number"
+	TypeObject  = "object"
+	TypeString  = "string"
+)
+
+// Schema is a JSON Schema (draft 2020-12) restricted to the keywords needed to describe the DTOs
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Id                   string             `json:"$id,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	ContentMediaType     string             `json:"contentMediaType,omitempty"`
+	Default              interface{}        `json:"default,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	ExclusiveMinimum     *float64           `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     *float64           `json:"exclusiveMaximum,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinProperties        *int               `json:"minProperties,omitempty"`
+	MaxProperties        *int               `json:"maxProperties,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	PropertyNames        *Schema            `json:"propertyNames,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+}
+
+// isEmpty tells whether the schema holds no keyword, so that it accepts any value
+func (s *Schema) isEmpty() bool {
+	return reflect.DeepEqual(*s, Schema{})
+}
+
+// merge adds the constraints of the other schema to the schema
+func (s *Schema) merge(other *Schema) {
+	if other.Format != "" {
+		s.Format = other.Format
+	}
+	if len(other.Enum) > 0 {
+		s.Enum = other.Enum
+	}
+	if other.Pattern != "" {
+		s.addPattern(other.Pattern)
+	}
+	mergeInt(&s.MinLength, other.MinLength)
+	mergeInt(&s.MaxLength, other.MaxLength)
+	mergeFloat(&s.Minimum, other.Minimum)
+	mergeFloat(&s.Maximum, other.Maximum)
+	mergeFloat(&s.ExclusiveMinimum, other.ExclusiveMinimum)
+	mergeFloat(&s.ExclusiveMaximum, other.ExclusiveMaximum)
+	mergeInt(&s.MinItems, other.MinItems)
+	mergeInt(&s.MaxItems, other.MaxItems)
+	mergeInt(&s.MinProperties, other.MinProperties)
+	mergeInt(&s.MaxProperties, other.MaxProperties)
+	s.AllOf = append(s.AllOf, other.AllOf...)
+	s.AnyOf = append(s.AnyOf, other.AnyOf...)
+}
+
+// addPattern sets the pattern of the schema, the patterns besides the first one being added to allOf as a schema holds
+// a single pattern keyword. The non-empty pattern is implied by the other patterns of the validate tags, so it never
+// takes precedence.
+func (s *Schema) addPattern(pattern string) {
+	switch {
+	case s.Pattern == "" || s.Pattern == nonEmptyPattern:
+		s.Pattern = pattern
+	case pattern == nonEmptyPattern || pattern == s.Pattern:
+	default:
+		s.AllOf = append(s.AllOf, &Schema{Pattern: pattern})
+	}
+}
+
+func mergeInt(dst **int, src *int) {
+	if src != nil {
+		*dst = src
+	}
+}
+
+func mergeFloat(dst **float64, src *float64) {
+	if src != nil {
+		*dst = src
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
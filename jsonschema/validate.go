@@ -0,0 +1,252 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+)
+
+const (
+	// nonEmptyPattern matches the strings accepted by the edgex-dto-none-empty-string tag, holding a non-space character
+	nonEmptyPattern = `\S`
+	// durationPattern matches the strings accepted by the edgex-dto-duration tag, parsed by time.ParseDuration
+	durationPattern = `^[-+]?(0|([0-9]*(\.[0-9]*)?(ns|us|µs|μs|ms|s|m|h))+)$`
+	// intervalDatetimePattern matches the strings accepted by the edgex-dto-interval-datetime tag, YYYYMMDD'T'HHmmss
+	intervalDatetimePattern = `^[0-9]{8}T[0-9]{6}$`
+)
+
+// oneOfValueRegex splits the parameter of the oneof tag like the validator does, a value being quoted when holding spaces
+var oneOfValueRegex = regexp.MustCompile(`'[^']*'|\S+`)
+
+// applyValidation translates the validate tag of a field of type t into the constraints of its schema and tells whether
+// the field is required. The rules without a JSON Schema counterpart, e.g. required_without, are ignored.
+func applyValidation(s *Schema, t reflect.Type, tag string) bool {
+	if tag == "" || tag == "-" {
+		return false
+	}
+	return applyRules(s, t, strings.Split(tag, ","), true)
+}
+
+func applyRules(s *Schema, t reflect.Type, rules []string, field bool) (required bool) {
+	kind := indirect(t).Kind()
+	if s.Type == TypeString {
+		// The byte slices are encoded as base64 strings
+		kind = reflect.String
+	}
+	isPtr := t.Kind() == reflect.Ptr
+	omitEmpty := false
+	constraints := &Schema{}
+	for i := 0; i < len(rules); i++ {
+		switch rules[i] {
+		case "required":
+			required = field
+			// The validator requires the pointers to be non-nil, and the other values to be non-zero
+			if kind == reflect.String && !isPtr {
+				constraints.MinLength = intPtr(1)
+			}
+		case "omitempty":
+			omitEmpty = true
+		case "dive":
+			applyDive(s, indirect(t), rules[i+1:])
+			i = len(rules)
+		default:
+			addConstraint(constraints, kind, rules[i])
+		}
+	}
+
+	if constraints.isEmpty() || s.Ref != "" {
+		return required
+	}
+	// The empty values skip the validation, only the pointers being validated whenever set
+	if omitEmpty && !isPtr {
+		switch kind {
+		case reflect.String:
+			s.AnyOf = append(s.AnyOf, &Schema{MaxLength: intPtr(0)}, constraints)
+			return required
+		case reflect.Slice, reflect.Array, reflect.Map:
+			// gt=0 is implied by the value being non-empty
+			if isNonEmptyConstraint(constraints) {
+				return required
+			}
+			empty := &Schema{MaxItems: intPtr(0)}
+			if kind == reflect.Map {
+				empty = &Schema{MaxProperties: intPtr(0)}
+			}
+			s.AnyOf = append(s.AnyOf, empty, constraints)
+			return required
+		}
+	}
+	s.merge(constraints)
+	return required
+}
+
+// applyDive applies the rules following dive to the items of an array or the values of a map, the rules enclosed in
+// keys and endkeys applying to the keys of the map
+func applyDive(s *Schema, t reflect.Type, rules []string) {
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		if s.Items != nil {
+			applyRules(s.Items, t.Elem(), rules, false)
+		}
+	case reflect.Map:
+		if len(rules) > 0 && rules[0] == "keys" {
+			end := len(rules)
+			for i, rule := range rules {
+				if rule == "endkeys" {
+					end = i
+					break
+				}
+			}
+			s.PropertyNames = &Schema{}
+			applyRules(s.PropertyNames, t.Key(), rules[1:end], false)
+			if end == len(rules) {
+				return
+			}
+			rules = rules[end+1:]
+		}
+		if s.AdditionalProperties != nil {
+			applyRules(s.AdditionalProperties, t.Elem(), rules, false)
+		}
+	}
+}
+
+// addConstraint adds the constraint translated from the rule to the schema, the alternatives separated by | being
+// translated to anyOf
+func addConstraint(s *Schema, kind reflect.Kind, rule string) {
+	if strings.Contains(rule, "|") {
+		alternatives := &Schema{}
+		for _, alternative := range strings.Split(rule, "|") {
+			constraint := &Schema{}
+			addConstraint(constraint, kind, alternative)
+			if constraint.isEmpty() {
+				// An alternative accepting any value makes the rule accept any value
+				return
+			}
+			alternatives.AnyOf = append(alternatives.AnyOf, constraint)
+		}
+		if len(s.AnyOf) == 0 {
+			s.AnyOf = alternatives.AnyOf
+		} else {
+			s.AllOf = append(s.AllOf, alternatives)
+		}
+		return
+	}
+
+	name, param := rule, ""
+	if i := strings.Index(rule, "="); i >= 0 {
+		name, param = rule[:i], rule[i+1:]
+	}
+	switch name {
+	case "oneof":
+		s.Enum = oneOfValues(kind, param)
+	case "len":
+		if n, err := strconv.Atoi(param); err == nil {
+			setBounds(s, kind, &n, &n, false)
+		}
+	case "gt":
+		if n, err := strconv.Atoi(param); err == nil {
+			setBounds(s, kind, &n, nil, true)
+		}
+	case "gte", "min":
+		if n, err := strconv.Atoi(param); err == nil {
+			setBounds(s, kind, &n, nil, false)
+		}
+	case "lt":
+		if n, err := strconv.Atoi(param); err == nil {
+			setBounds(s, kind, nil, &n, true)
+		}
+	case "lte", "max":
+		if n, err := strconv.Atoi(param); err == nil {
+			setBounds(s, kind, nil, &n, false)
+		}
+	case "uuid", "edgex-dto-uuid":
+		s.Format = "uuid"
+	case "email":
+		s.Format = "email"
+	case "uri", "url":
+		s.Format = "uri"
+	case "edgex-dto-none-empty-string":
+		s.addPattern(nonEmptyPattern)
+	case "edgex-dto-rfc3986-unreserved-chars":
+		s.addPattern(common.RFC3986UnreservedCharsRegexString)
+	case "edgex-dto-duration":
+		s.addPattern(durationPattern)
+	case "edgex-dto-interval-datetime":
+		s.addPattern(intervalDatetimePattern)
+	case "edgex-dto-value-type":
+		s.addPattern(valueTypePattern)
+	}
+}
+
+// setBounds translates the bounds of the len, gt, gte, lt and lte rules, which bound the length of the strings, the
+// number of items of the arrays and of properties of the maps, and the numbers themselves
+func setBounds(s *Schema, kind reflect.Kind, lower *int, upper *int, exclusive bool) {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if lower != nil && exclusive {
+			s.ExclusiveMinimum = floatPtr(float64(*lower))
+		} else if lower != nil {
+			s.Minimum = floatPtr(float64(*lower))
+		}
+		if upper != nil && exclusive {
+			s.ExclusiveMaximum = floatPtr(float64(*upper))
+		} else if upper != nil {
+			s.Maximum = floatPtr(float64(*upper))
+		}
+		return
+	}
+
+	var min, max *int
+	if lower != nil && exclusive {
+		min = intPtr(*lower + 1)
+	} else if lower != nil {
+		min = intPtr(*lower)
+	}
+	if upper != nil && exclusive {
+		max = intPtr(*upper - 1)
+	} else if upper != nil {
+		max = intPtr(*upper)
+	}
+	switch kind {
+	case reflect.String:
+		mergeInt(&s.MinLength, min)
+		mergeInt(&s.MaxLength, max)
+	case reflect.Slice, reflect.Array:
+		mergeInt(&s.MinItems, min)
+		mergeInt(&s.MaxItems, max)
+	case reflect.Map:
+		mergeInt(&s.MinProperties, min)
+		mergeInt(&s.MaxProperties, max)
+	}
+}
+
+// isNonEmptyConstraint tells whether the constraint only requires an array or a map to be non-empty
+func isNonEmptyConstraint(s *Schema) bool {
+	one := 1
+	return reflect.DeepEqual(*s, Schema{MinItems: &one}) || reflect.DeepEqual(*s, Schema{MinProperties: &one})
+}
+
+func oneOfValues(kind reflect.Kind, param string) []interface{} {
+	var values []interface{}
+	for _, value := range oneOfValueRegex.FindAllString(param, -1) {
+		value = strings.Trim(value, "'")
+		if kind != reflect.String {
+			if n, err := strconv.ParseFloat(value, 64); err == nil {
+				values = append(values, n)
+				continue
+			}
+		}
+		values = append(values, value)
+	}
+	return values
+}
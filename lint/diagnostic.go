@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lint reports the mistakes of the device profiles which pass the DTO validation or are only reported one at a
+// time by it, each diagnostic locating the mistake in the profile YAML.
+package lint
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Severity tells how serious the issue reported by a diagnostic is
+type Severity string
+
+const (
+	// SeverityError reports a profile rejected by core-metadata or a device service
+	SeverityError Severity = "error"
+	// SeverityWarning reports a profile accepted but unlikely to behave as intended
+	SeverityWarning Severity = "warning"
+)
+
+// Diagnostic reports an issue of a profile. Path locates the profile element, e.g. deviceResources[0].properties.scale,
+// and Line and Column its position in the YAML, both starting at 1 and 0 when the profile was not read from YAML.
+type Diagnostic struct {
+	Severity Severity `json:"severity"`
+	Rule     string   `json:"rule"`
+	Message  string   `json:"message"`
+	Path     string   `json:"path"`
+	Line     int      `json:"line,omitempty"`
+	Column   int      `json:"column,omitempty"`
+}
+
+func (d Diagnostic) String() string {
+	if d.Line == 0 {
+		return fmt.Sprintf("%s: %s: %s (%s)", d.Path, d.Severity, d.Message, d.Rule)
+	}
+	return fmt.Sprintf("%d:%d: %s: %s (%s)", d.Line, d.Column, d.Severity, d.Message, d.Rule)
+}
+
+// HasErrors tells whether one of the diagnostics is an error
+func HasErrors(diagnostics []Diagnostic) bool {
+	for _, d := range diagnostics {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func sortDiagnostics(diagnostics []Diagnostic) {
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		if diagnostics[i].Line != diagnostics[j].Line {
+			return diagnostics[i].Line < diagnostics[j].Line
+		}
+		return diagnostics[i].Column < diagnostics[j].Column
+	})
+}
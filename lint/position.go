@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type position struct {
+	line   int
+	column int
+}
+
+// positions maps the paths of the elements of a YAML document to their position, the position of a mapping entry
+// being the one of its key
+type positions map[string]position
+
+func newPositions(root *yaml.Node) positions {
+	p := make(positions)
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	p.add("", root, root)
+	return p
+}
+
+func (p positions) add(path string, at *yaml.Node, node *yaml.Node) {
+	p[path] = position{line: at.Line, column: at.Column}
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			p.add(joinPath(path, key.Value), key, value)
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			p.add(fmt.Sprintf("%s[%d]", path, i), item, item)
+		}
+	case yaml.AliasNode:
+		if node.Alias != nil {
+			p.add(path, at, node.Alias)
+		}
+	}
+}
+
+// locate returns the position of the element at the path, or of its closest ancestor present in the document when the
+// element is missing
+func (p positions) locate(path string) position {
+	for {
+		if pos, ok := p[path]; ok {
+			return pos
+		}
+		if path == "" {
+			return position{}
+		}
+		i := strings.LastIndexAny(path, ".[")
+		if i < 0 {
+			path = ""
+		} else {
+			path = path[:i]
+		}
+	}
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
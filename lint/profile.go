@@ -0,0 +1,253 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// The rules checked by the linter
+const (
+	RuleInvalidYaml               = "invalid-yaml"
+	RuleInvalidValueType          = "invalid-value-type"
+	RuleInvalidReadWrite          = "invalid-read-write"
+	RuleDuplicateName             = "duplicate-name"
+	RuleCaseInsensitiveDuplicate  = "case-insensitive-duplicate"
+	RuleUnknownResource           = "unknown-resource"
+	RuleReadWriteOnlyResource     = "read-write-only-resource"
+	RuleReadHiddenResource        = "read-hidden-resource"
+	RuleMappingsOnNonString       = "mappings-on-non-string"
+	RuleInvalidNumber             = "invalid-number"
+	RuleMinimumGreaterThanMaximum = "minimum-greater-than-maximum"
+	RuleTransformOnNonNumeric     = "transform-on-non-numeric"
+	RuleMissingMediaType          = "missing-media-type"
+)
+
+// yamlLineRegex extracts the line of the type errors reported by the YAML decoder, e.g. "line 12: cannot unmarshal ..."
+var yamlLineRegex = regexp.MustCompile(`^line (\d+): (.*)$`)
+
+// profileAlias decodes the profile YAML without the validation done by dtos.DeviceProfile.UnmarshalYAML, which stops at
+// the first issue
+type profileAlias struct {
+	dtos.DBTimestamp
+	dtos.DeviceProfileBasicInfo `yaml:",inline"`
+	DeviceResources             []dtos.DeviceResource `yaml:"deviceResources"`
+	DeviceCommands              []dtos.DeviceCommand  `yaml:"deviceCommands"`
+}
+
+// DeviceProfileYaml lints the device profile YAML, the diagnostics being sorted by position. An error is returned when
+// the document is not a YAML mapping.
+func DeviceProfileYaml(data []byte) ([]Diagnostic, errors.EdgeX) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the device profile YAML", err)
+	}
+	if len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the device profile YAML is not a mapping", nil)
+	}
+
+	var diagnostics []Diagnostic
+	var alias profileAlias
+	if err := root.Decode(&alias); err != nil {
+		typeErr, ok := err.(*yaml.TypeError)
+		if !ok {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the device profile YAML", err)
+		}
+		// The fields of the wrong type are left empty and the rest of the profile is still linted
+		for _, message := range typeErr.Errors {
+			d := Diagnostic{Severity: SeverityError, Rule: RuleInvalidYaml, Message: message}
+			if match := yamlLineRegex.FindStringSubmatch(message); match != nil {
+				d.Line, _ = strconv.Atoi(match[1])
+				d.Message = match[2]
+			}
+			diagnostics = append(diagnostics, d)
+		}
+	}
+
+	positions := newPositions(&root)
+	for _, d := range DeviceProfile(dtos.DeviceProfile(alias)) {
+		pos := positions.locate(d.Path)
+		d.Line, d.Column = pos.line, pos.column
+		diagnostics = append(diagnostics, d)
+	}
+	sortDiagnostics(diagnostics)
+	return diagnostics, nil
+}
+
+// DeviceProfile lints the device profile, the diagnostics being ordered by the profile elements they report
+func DeviceProfile(profile dtos.DeviceProfile) []Diagnostic {
+	l := &linter{resources: make(map[string]dtos.DeviceResource)}
+	for _, resource := range profile.DeviceResources {
+		if _, ok := l.resources[resource.Name]; !ok {
+			l.resources[resource.Name] = resource
+		}
+	}
+
+	l.checkNames("deviceResources", "device resource", resourceNames(profile.DeviceResources))
+	for i, resource := range profile.DeviceResources {
+		l.checkResource(fmt.Sprintf("deviceResources[%d]", i), resource)
+	}
+	l.checkNames("deviceCommands", "device command", commandNames(profile.DeviceCommands))
+	for i, command := range profile.DeviceCommands {
+		l.checkCommand(fmt.Sprintf("deviceCommands[%d]", i), command)
+	}
+	return l.diagnostics
+}
+
+type linter struct {
+	resources   map[string]dtos.DeviceResource
+	diagnostics []Diagnostic
+}
+
+func (l *linter) report(severity Severity, rule string, path string, format string, args ...interface{}) {
+	l.diagnostics = append(l.diagnostics, Diagnostic{Severity: severity, Rule: rule, Message: fmt.Sprintf(format, args...), Path: path})
+}
+
+// checkNames reports the names used twice, and the names differing only in case which clash on the case-insensitive
+// lookups, e.g. in the databases of core-metadata
+func (l *linter) checkNames(path string, kind string, names []string) {
+	seen := make(map[string]string, len(names))
+	for i, name := range names {
+		namePath := fmt.Sprintf("%s[%d].name", path, i)
+		first, ok := seen[strings.ToLower(name)]
+		switch {
+		case !ok:
+			seen[strings.ToLower(name)] = name
+		case first == name:
+			l.report(SeverityError, RuleDuplicateName, namePath, "%s %s is duplicated", kind, name)
+		default:
+			l.report(SeverityWarning, RuleCaseInsensitiveDuplicate, namePath, "%s %s only differs in case from %s", kind, name, first)
+		}
+	}
+}
+
+func (l *linter) checkResource(path string, resource dtos.DeviceResource) {
+	properties := resource.Properties
+	propertiesPath := path + ".properties"
+
+	valueType, err := common.NormalizeValueType(properties.ValueType)
+	if err != nil {
+		l.report(SeverityError, RuleInvalidValueType, propertiesPath+".valueType", "device resource %s has the unknown value type '%s'", resource.Name, properties.ValueType)
+		return
+	}
+	if !validReadWrite(properties.ReadWrite) {
+		l.report(SeverityError, RuleInvalidReadWrite, propertiesPath+".readWrite", "device resource %s has the invalid readWrite '%s', expecting R, W, RW or WR", resource.Name, properties.ReadWrite)
+	}
+
+	if valueType == common.ValueTypeBinary && properties.MediaType == "" {
+		l.report(SeverityError, RuleMissingMediaType, propertiesPath, "device resource %s of value type %s has no mediaType", resource.Name, valueType)
+	}
+
+	numeric := isNumeric(valueType)
+	transforms := []struct {
+		name  string
+		value string
+	}{
+		{"minimum", properties.Minimum},
+		{"maximum", properties.Maximum},
+		{"mask", properties.Mask},
+		{"shift", properties.Shift},
+		{"scale", properties.Scale},
+		{"offset", properties.Offset},
+		{"base", properties.Base},
+	}
+	for _, transform := range transforms {
+		if transform.value == "" {
+			continue
+		}
+		if !numeric {
+			l.report(SeverityWarning, RuleTransformOnNonNumeric, propertiesPath+"."+transform.name, "%s of device resource %s is ignored for the non-numeric value type %s", transform.name, resource.Name, valueType)
+			continue
+		}
+		if _, err := strconv.ParseFloat(transform.value, 64); err != nil {
+			l.report(SeverityError, RuleInvalidNumber, propertiesPath+"."+transform.name, "%s of device resource %s is not a number: '%s'", transform.name, resource.Name, transform.value)
+		}
+	}
+	if !numeric || properties.Minimum == "" || properties.Maximum == "" {
+		return
+	}
+	minimum, minErr := strconv.ParseFloat(properties.Minimum, 64)
+	maximum, maxErr := strconv.ParseFloat(properties.Maximum, 64)
+	if minErr == nil && maxErr == nil && minimum > maximum {
+		l.report(SeverityError, RuleMinimumGreaterThanMaximum, propertiesPath+".minimum", "minimum %s of device resource %s is greater than its maximum %s", properties.Minimum, resource.Name, properties.Maximum)
+	}
+}
+
+func (l *linter) checkCommand(path string, command dtos.DeviceCommand) {
+	if !validReadWrite(command.ReadWrite) {
+		l.report(SeverityError, RuleInvalidReadWrite, path+".readWrite", "device command %s has the invalid readWrite '%s', expecting R, W, RW or WR", command.Name, command.ReadWrite)
+	}
+	reads := strings.Contains(command.ReadWrite, common.ReadWrite_R)
+	writes := strings.Contains(command.ReadWrite, common.ReadWrite_W)
+
+	for i, ro := range command.ResourceOperations {
+		roPath := fmt.Sprintf("%s.resourceOperations[%d]", path, i)
+		resource, ok := l.resources[ro.DeviceResource]
+		if !ok {
+			l.report(SeverityError, RuleUnknownResource, roPath+".deviceResource", "device command %s references the unknown device resource %s", command.Name, ro.DeviceResource)
+			continue
+		}
+
+		readWrite := resource.Properties.ReadWrite
+		if reads && !strings.Contains(readWrite, common.ReadWrite_R) {
+			l.report(SeverityError, RuleReadWriteOnlyResource, roPath+".deviceResource", "device command %s reads the write-only device resource %s", command.Name, resource.Name)
+		}
+		if writes && !strings.Contains(readWrite, common.ReadWrite_W) {
+			l.report(SeverityError, RuleReadWriteOnlyResource, roPath+".deviceResource", "device command %s writes the read-only device resource %s", command.Name, resource.Name)
+		}
+		if reads && resource.IsHidden {
+			l.report(SeverityWarning, RuleReadHiddenResource, roPath+".deviceResource", "device command %s reads the hidden device resource %s", command.Name, resource.Name)
+		}
+
+		valueType, err := common.NormalizeValueType(resource.Properties.ValueType)
+		if err == nil && len(ro.Mappings) > 0 && valueType != common.ValueTypeString {
+			l.report(SeverityWarning, RuleMappingsOnNonString, roPath+".mappings", "mappings of device command %s are ignored for the device resource %s of value type %s", command.Name, resource.Name, valueType)
+		}
+	}
+}
+
+func validReadWrite(readWrite string) bool {
+	switch readWrite {
+	case common.ReadWrite_R, common.ReadWrite_W, common.ReadWrite_RW, common.ReadWrite_WR:
+		return true
+	}
+	return false
+}
+
+func isNumeric(valueType string) bool {
+	switch valueType {
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64,
+		common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64,
+		common.ValueTypeFloat32, common.ValueTypeFloat64:
+		return true
+	}
+	return false
+}
+
+func resourceNames(resources []dtos.DeviceResource) []string {
+	names := make([]string, len(resources))
+	for i, resource := range resources {
+		names[i] = resource.Name
+	}
+	return names
+}
+
+func commandNames(commands []dtos.DeviceCommand) []string {
+	names := make([]string, len(commands))
+	for i, command := range commands {
+		names[i] = command.Name
+	}
+	return names
+}
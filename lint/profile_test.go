@@ -0,0 +1,197 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+const validProfile = `name: "Simple-Device"
+manufacturer: "Simple Corp."
+deviceResources:
+  - name: "SwitchButton"
+    properties:
+      valueType: "Bool"
+      readWrite: "RW"
+  - name: "Xrotation"
+    properties:
+      valueType: "Int32"
+      readWrite: "RW"
+      minimum: "-180"
+      maximum: "180"
+  - name: "Image"
+    properties:
+      valueType: "Binary"
+      readWrite: "R"
+      mediaType: "image/jpeg"
+deviceCommands:
+  - name: "Switch"
+    readWrite: "RW"
+    resourceOperations:
+      - { deviceResource: "SwitchButton", defaultValue: "false" }
+`
+
+const invalidProfile = `name: "Simple-Device"
+deviceResources:
+  - name: "Temperature"
+    properties:
+      valueType: "Float32"
+      readWrite: "R"
+      minimum: "100"
+      maximum: "-100"
+  - name: "temperature"
+    properties:
+      valueType: "String"
+      readWrite: "W"
+      scale: "0.1"
+  - name: "Image"
+    isHidden: true
+    properties:
+      valueType: "Binary"
+      readWrite: "R"
+  - name: "Counter"
+    properties:
+      valueType: "Uint8"
+      readWrite: "RW"
+      maximum: "ten"
+deviceCommands:
+  - name: "Read"
+    readWrite: "R"
+    resourceOperations:
+      - deviceResource: "temperature"
+      - deviceResource: "Image"
+      - deviceResource: "Counter"
+        mappings:
+          "0": "off"
+      - deviceResource: "Missing"
+`
+
+func TestDeviceProfileYaml(t *testing.T) {
+	diagnostics, err := DeviceProfileYaml([]byte(validProfile))
+	require.NoError(t, err)
+	assert.Empty(t, diagnostics)
+
+	diagnostics, err = DeviceProfileYaml([]byte(invalidProfile))
+	require.NoError(t, err)
+	expected := []Diagnostic{
+		{Severity: SeverityError, Rule: RuleMinimumGreaterThanMaximum, Path: "deviceResources[0].properties.minimum", Line: 7, Column: 7},
+		{Severity: SeverityWarning, Rule: RuleCaseInsensitiveDuplicate, Path: "deviceResources[1].name", Line: 9, Column: 5},
+		{Severity: SeverityWarning, Rule: RuleTransformOnNonNumeric, Path: "deviceResources[1].properties.scale", Line: 13, Column: 7},
+		{Severity: SeverityError, Rule: RuleMissingMediaType, Path: "deviceResources[2].properties", Line: 16, Column: 5},
+		{Severity: SeverityError, Rule: RuleInvalidNumber, Path: "deviceResources[3].properties.maximum", Line: 23, Column: 7},
+		{Severity: SeverityError, Rule: RuleReadWriteOnlyResource, Path: "deviceCommands[0].resourceOperations[0].deviceResource", Line: 28, Column: 9},
+		{Severity: SeverityWarning, Rule: RuleReadHiddenResource, Path: "deviceCommands[0].resourceOperations[1].deviceResource", Line: 29, Column: 9},
+		{Severity: SeverityWarning, Rule: RuleMappingsOnNonString, Path: "deviceCommands[0].resourceOperations[2].mappings", Line: 31, Column: 9},
+		{Severity: SeverityError, Rule: RuleUnknownResource, Path: "deviceCommands[0].resourceOperations[3].deviceResource", Line: 33, Column: 9},
+	}
+	require.Len(t, diagnostics, len(expected))
+	for i, d := range diagnostics {
+		assert.NotEmpty(t, d.Message)
+		d.Message = ""
+		assert.Equal(t, expected[i], d)
+	}
+	assert.True(t, HasErrors(diagnostics))
+}
+
+func TestDeviceProfileYamlTypeError(t *testing.T) {
+	profile := `name: "Simple-Device"
+deviceResources:
+  - name: "Switch"
+    isHidden: "maybe"
+    properties:
+      valueType: "Bool"
+      readWrite: "RW"
+      mediaType: [ "text/plain" ]
+`
+	diagnostics, err := DeviceProfileYaml([]byte(profile))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 2)
+	for i, line := range []int{4, 8} {
+		assert.Equal(t, RuleInvalidYaml, diagnostics[i].Rule)
+		assert.Equal(t, SeverityError, diagnostics[i].Severity)
+		assert.Equal(t, line, diagnostics[i].Line)
+	}
+}
+
+func TestDeviceProfileYamlInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile string
+	}{
+		{"malformed", "name: [\n"},
+		{"not a mapping", "- name: Simple-Device\n"},
+		{"empty", ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := DeviceProfileYaml([]byte(testCase.profile))
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+}
+
+func TestDeviceProfile(t *testing.T) {
+	resource := func(name string, valueType string, readWrite string) dtos.DeviceResource {
+		return dtos.DeviceResource{Name: name, Properties: dtos.ResourceProperties{ValueType: valueType, ReadWrite: readWrite}}
+	}
+	tests := []struct {
+		name     string
+		profile  dtos.DeviceProfile
+		expected []string
+	}{
+		{"valid", dtos.DeviceProfile{
+			DeviceResources: []dtos.DeviceResource{resource("Switch", common.ValueTypeBool, common.ReadWrite_RW)},
+		}, nil},
+		{"duplicated names", dtos.DeviceProfile{
+			DeviceResources: []dtos.DeviceResource{resource("Switch", common.ValueTypeBool, common.ReadWrite_RW), resource("Switch", common.ValueTypeBool, common.ReadWrite_R)},
+			DeviceCommands: []dtos.DeviceCommand{
+				{Name: "Command", ReadWrite: common.ReadWrite_R, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "Switch"}}},
+				{Name: "COMMAND", ReadWrite: common.ReadWrite_R, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "Switch"}}},
+			},
+		}, []string{RuleDuplicateName, RuleCaseInsensitiveDuplicate}},
+		{"invalid value type and read write", dtos.DeviceProfile{
+			DeviceResources: []dtos.DeviceResource{resource("Switch", "Boolean", common.ReadWrite_RW), resource("Level", common.ValueTypeInt8, "X")},
+		}, []string{RuleInvalidValueType, RuleInvalidReadWrite}},
+		{"value type normalized", dtos.DeviceProfile{
+			DeviceResources: []dtos.DeviceResource{resource("Level", "int8", common.ReadWrite_RW)},
+			DeviceCommands: []dtos.DeviceCommand{
+				{Name: "Set", ReadWrite: common.ReadWrite_W, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "Level", Mappings: map[string]string{"1": "on"}}}},
+			},
+		}, []string{RuleMappingsOnNonString}},
+		{"write read-only resource", dtos.DeviceProfile{
+			DeviceResources: []dtos.DeviceResource{resource("Level", common.ValueTypeString, common.ReadWrite_R)},
+			DeviceCommands: []dtos.DeviceCommand{
+				{Name: "Set", ReadWrite: common.ReadWrite_RW, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "Level", Mappings: map[string]string{"1": "on"}}}},
+			},
+		}, []string{RuleReadWriteOnlyResource}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			diagnostics := DeviceProfile(testCase.profile)
+			var rules []string
+			for _, d := range diagnostics {
+				assert.Zero(t, d.Line)
+				rules = append(rules, d.Rule)
+			}
+			assert.Equal(t, testCase.expected, rules)
+		})
+	}
+}
+
+func TestDiagnosticString(t *testing.T) {
+	d := Diagnostic{Severity: SeverityError, Rule: RuleUnknownResource, Message: "unknown", Path: "deviceCommands[0]"}
+	assert.Equal(t, "deviceCommands[0]: error: unknown (unknown-resource)", d.String())
+	d.Line, d.Column = 3, 5
+	assert.Equal(t, "3:5: error: unknown (unknown-resource)", d.String())
+}
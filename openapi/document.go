@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package openapi generates the OpenAPI 3 documents of the EdgeX service APIs from the route registry and the request
+// and response DTOs, so that the published API specification can be checked against the contracts.
+package openapi
+
+import "github.com/edgexfoundry/go-mod-core-contracts/v3/jsonschema"
+
+// Version is the version of the OpenAPI specification the documents follow, 3.1 using JSON Schema draft 2020-12
+const Version = "3.1.0"
+
+// Document is an OpenAPI document describing the API of an EdgeX service
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+// Info holds the metadata of the API
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// Components holds the schemas of the DTOs referenced by the operations
+type Components struct {
+	Schemas map[string]*jsonschema.Schema `json:"schemas"`
+}
+
+// PathItem describes the operations available on a route
+type PathItem struct {
+	Get    *Operation `json:"get,omitempty"`
+	Put    *Operation `json:"put,omitempty"`
+	Post   *Operation `json:"post,omitempty"`
+	Delete *Operation `json:"delete,omitempty"`
+	Patch  *Operation `json:"patch,omitempty"`
+}
+
+// Operation describes an API operation on a route
+type Operation struct {
+	OperationId string               `json:"operationId"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter describes a path or query parameter of an operation
+type Parameter struct {
+	Name     string             `json:"name"`
+	In       string             `json:"in"`
+	Required bool               `json:"required,omitempty"`
+	Schema   *jsonschema.Schema `json:"schema"`
+}
+
+// RequestBody describes the body of the requests of an operation by content type
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response describes a response of an operation by content type
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a body for a content type
+type MediaType struct {
+	Schema *jsonschema.Schema `json:"schema"`
+}
@@ -0,0 +1,194 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/jsonschema"
+)
+
+const schemasRefPrefix = "#/components/schemas/"
+
+// integerParams are the route parameters holding integers, the others holding strings
+var integerParams = map[string]bool{common.Start: true, common.End: true, common.Age: true}
+
+// ServiceKeys returns the keys of the services whose API can be generated, sorted
+func ServiceKeys() []string {
+	keys := make([]string, 0, len(serviceOperations))
+	for key := range serviceOperations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Generate returns the OpenAPI document of the API of the service, its operations being described by the routes of the
+// route registry and the schemas of the request and response DTOs
+func Generate(serviceKey string) (Document, errors.EdgeX) {
+	operations, ok := serviceOperations[serviceKey]
+	if !ok {
+		return Document{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown service %s", serviceKey), nil)
+	}
+
+	reflector := jsonschema.NewReflector(schemasRefPrefix)
+	doc := Document{
+		OpenAPI: Version,
+		Info:    Info{Title: serviceKey + " API", Version: common.ApiVersion},
+		Paths:   make(map[string]*PathItem),
+	}
+	for _, op := range append(append([]operation{}, commonOperations...), operations...) {
+		route, ok := routes.Lookup(op.template)
+		if !ok {
+			return Document{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("route %s is not registered", op.template), nil)
+		}
+		if !route.Allows(op.method) {
+			return Document{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("route %s does not accept %s", op.template, op.method), nil)
+		}
+
+		item, ok := doc.Paths[route.Template]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[route.Template] = item
+		}
+		slot := item.operation(op.method)
+		if *slot != nil {
+			return Document{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s %s is described twice", op.method, op.template), nil)
+		}
+		*slot = newOperation(reflector, route, op)
+	}
+	doc.Components.Schemas = reflector.Definitions
+	return doc, nil
+}
+
+func newOperation(reflector *jsonschema.Reflector, route routes.Route, op operation) *Operation {
+	o := &Operation{
+		OperationId: operationId(route, op.method),
+		Responses: map[string]*Response{
+			strconv.Itoa(op.status): {
+				Description: http.StatusText(op.status),
+				Content:     content(reflector.Reflect(op.response), op.responseContent),
+			},
+			// The errors are reported by a BaseResponse holding the status code and the message
+			"default": {
+				Description: "Error",
+				Content:     content(reflector.Reflect(dtoCommon.BaseResponse{}), nil),
+			},
+		},
+	}
+	for _, param := range route.Params() {
+		o.Parameters = append(o.Parameters, Parameter{Name: param, In: "path", Required: true, Schema: paramSchema(param)})
+	}
+	for _, param := range op.query {
+		o.Parameters = append(o.Parameters, Parameter{Name: param, In: "query", Schema: querySchema(param)})
+	}
+
+	switch {
+	case len(op.requestContent) == 1 && op.requestContent[0] == contentTypeMultipart:
+		// The multipart requests upload a file
+		file := &jsonschema.Schema{Type: jsonschema.TypeString, ContentMediaType: "application/octet-stream"}
+		upload := &jsonschema.Schema{Type: jsonschema.TypeObject, Properties: map[string]*jsonschema.Schema{"file": file}, Required: []string{"file"}}
+		o.RequestBody = &RequestBody{Required: true, Content: content(upload, op.requestContent)}
+	case op.request != nil:
+		// The readings query by time range takes an optional body
+		o.RequestBody = &RequestBody{Required: op.method != http.MethodGet, Content: content(reflector.Reflect(op.request), op.requestContent)}
+	}
+	return o
+}
+
+func content(schema *jsonschema.Schema, contentTypes []string) map[string]MediaType {
+	if len(contentTypes) == 0 {
+		contentTypes = []string{common.ContentTypeJSON}
+	}
+	c := make(map[string]MediaType, len(contentTypes))
+	for _, contentType := range contentTypes {
+		c[contentType] = MediaType{Schema: schema}
+	}
+	return c
+}
+
+func paramSchema(param string) *jsonschema.Schema {
+	if integerParams[param] {
+		return &jsonschema.Schema{Type: jsonschema.TypeInteger, Format: "int64"}
+	}
+	return &jsonschema.Schema{Type: jsonschema.TypeString}
+}
+
+func querySchema(param string) *jsonschema.Schema {
+	switch param {
+	case common.Offset:
+		return &jsonschema.Schema{Type: jsonschema.TypeInteger, Minimum: floatPtr(0), Default: common.DefaultOffset}
+	case common.Limit:
+		// A negative limit returns all the results
+		return &jsonschema.Schema{Type: jsonschema.TypeInteger, Minimum: floatPtr(-1), Default: common.DefaultLimit}
+	case common.PushEvent, common.ReturnEvent:
+		return &jsonschema.Schema{Type: jsonschema.TypeBoolean}
+	}
+	// The labels and services hold a comma-separated list
+	return &jsonschema.Schema{Type: jsonschema.TypeString}
+}
+
+// operationId names the operation after its method and the route template, e.g. getDeviceByName for the GET method of
+// /api/v2/device/name/{name} and getEventByStartAndEnd for /api/v2/event/start/{start}/end/{end}
+func operationId(route routes.Route, method string) string {
+	id := strings.ToLower(method)
+	segments := strings.Split(strings.TrimPrefix(route.Template, common.ApiBase+"/"), "/")
+	afterParam := false
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") {
+			if afterParam {
+				id += "And"
+			} else {
+				id += "By"
+			}
+			id += upperFirst(strings.Trim(segment, "{}"))
+			afterParam = true
+			continue
+		}
+		// The literal segment naming the following parameter is implied by the parameter, e.g. name/{name}
+		if i+1 < len(segments) && segments[i+1] == "{"+segment+"}" {
+			continue
+		}
+		id += upperFirst(segment)
+		afterParam = false
+	}
+	return id
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return string(unicode.ToUpper(rune(s[0]))) + s[1:]
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+// operation returns the slot of the operation of the method
+func (p *PathItem) operation(method string) **Operation {
+	switch method {
+	case http.MethodGet:
+		return &p.Get
+	case http.MethodPut:
+		return &p.Put
+	case http.MethodPost:
+		return &p.Post
+	case http.MethodDelete:
+		return &p.Delete
+	}
+	return &p.Patch
+}
@@ -0,0 +1,199 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/routes"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+var methods = []string{http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete, http.MethodPatch}
+
+func TestGenerate(t *testing.T) {
+	for _, serviceKey := range ServiceKeys() {
+		t.Run(serviceKey, func(t *testing.T) {
+			doc, err := Generate(serviceKey)
+			require.NoError(t, err)
+			assert.Equal(t, Version, doc.OpenAPI)
+			assert.Contains(t, doc.Paths, common.ApiPingRoute)
+
+			operationIds := make(map[string]bool)
+			for template, item := range doc.Paths {
+				route, ok := routes.Lookup(template)
+				require.True(t, ok)
+				for _, method := range methods {
+					op := *item.operation(method)
+					if op == nil {
+						continue
+					}
+					assert.False(t, operationIds[op.OperationId], "duplicated operation id %s", op.OperationId)
+					operationIds[op.OperationId] = true
+
+					var pathParams []string
+					for _, param := range op.Parameters {
+						if param.In == "path" {
+							pathParams = append(pathParams, param.Name)
+						}
+					}
+					assert.Equal(t, route.Params(), pathParams, "%s %s", method, template)
+					assert.Contains(t, op.Responses, "default")
+				}
+			}
+
+			// The references point to the components
+			data, jsonErr := json.Marshal(doc)
+			require.NoError(t, jsonErr)
+			for _, ref := range strings.Split(string(data), `"$ref":"`)[1:] {
+				name := strings.TrimPrefix(ref[:strings.Index(ref, `"`)], schemasRefPrefix)
+				assert.Contains(t, doc.Components.Schemas, name)
+			}
+		})
+	}
+}
+
+func TestGenerateCoversRoutes(t *testing.T) {
+	described := make(map[string]bool)
+	for _, serviceKey := range ServiceKeys() {
+		doc, err := Generate(serviceKey)
+		require.NoError(t, err)
+		for template, item := range doc.Paths {
+			for _, method := range methods {
+				if *item.operation(method) != nil {
+					described[method+" "+template] = true
+				}
+			}
+		}
+	}
+	for _, route := range routes.All() {
+		for _, method := range route.Methods {
+			assert.True(t, described[method+" "+route.Template], "%s %s is not described", method, route.Template)
+		}
+	}
+}
+
+// TestGeneratePublishedPaths checks the documents against paths of the published EdgeX API specifications of core
+// metadata and core data, spelled out so that a change of a route constant does not go unnoticed
+func TestGeneratePublishedPaths(t *testing.T) {
+	tests := []struct {
+		serviceKey string
+		path       string
+		methods    []string
+	}{
+		{common.CoreMetaDataServiceKey, "/api/v2/device", []string{http.MethodPost, http.MethodPatch}},
+		{common.CoreMetaDataServiceKey, "/api/v2/device/all", []string{http.MethodGet}},
+		{common.CoreMetaDataServiceKey, "/api/v2/device/name/{name}", []string{http.MethodGet, http.MethodDelete}},
+		{common.CoreMetaDataServiceKey, "/api/v2/device/check/name/{name}", []string{http.MethodGet}},
+		{common.CoreMetaDataServiceKey, "/api/v2/device/profile/name/{name}", []string{http.MethodGet}},
+		{common.CoreMetaDataServiceKey, "/api/v2/device/service/name/{name}", []string{http.MethodGet}},
+		{common.CoreMetaDataServiceKey, "/api/v2/deviceprofile", []string{http.MethodPost, http.MethodPut}},
+		{common.CoreMetaDataServiceKey, "/api/v2/deviceprofile/uploadfile", []string{http.MethodPost, http.MethodPut}},
+		{common.CoreMetaDataServiceKey, "/api/v2/deviceprofile/all", []string{http.MethodGet}},
+		{common.CoreMetaDataServiceKey, "/api/v2/deviceprofile/name/{name}", []string{http.MethodGet, http.MethodDelete}},
+		{common.CoreMetaDataServiceKey, "/api/v2/deviceservice", []string{http.MethodPost, http.MethodPatch}},
+		{common.CoreMetaDataServiceKey, "/api/v2/deviceservice/all", []string{http.MethodGet}},
+		{common.CoreMetaDataServiceKey, "/api/v2/deviceservice/name/{name}", []string{http.MethodGet, http.MethodDelete}},
+		{common.CoreMetaDataServiceKey, "/api/v2/provisionwatcher", []string{http.MethodPost, http.MethodPatch}},
+		{common.CoreMetaDataServiceKey, "/api/v2/provisionwatcher/name/{name}", []string{http.MethodGet, http.MethodDelete}},
+		{common.CoreDataServiceKey, "/api/v2/event/{profileName}/{deviceName}/{sourceName}", []string{http.MethodPost}},
+		{common.CoreDataServiceKey, "/api/v2/event/all", []string{http.MethodGet}},
+		{common.CoreDataServiceKey, "/api/v2/event/count", []string{http.MethodGet}},
+		{common.CoreDataServiceKey, "/api/v2/event/count/device/name/{name}", []string{http.MethodGet}},
+		{common.CoreDataServiceKey, "/api/v2/event/device/name/{name}", []string{http.MethodGet, http.MethodDelete}},
+		{common.CoreDataServiceKey, "/api/v2/event/id/{id}", []string{http.MethodGet, http.MethodDelete}},
+		{common.CoreDataServiceKey, "/api/v2/event/age/{age}", []string{http.MethodDelete}},
+		{common.CoreDataServiceKey, "/api/v2/event/start/{start}/end/{end}", []string{http.MethodGet}},
+		{common.CoreDataServiceKey, "/api/v2/reading/all", []string{http.MethodGet}},
+		{common.CoreDataServiceKey, "/api/v2/reading/count", []string{http.MethodGet}},
+		{common.CoreDataServiceKey, "/api/v2/reading/device/name/{name}", []string{http.MethodGet}},
+		{common.CoreDataServiceKey, "/api/v2/reading/resourceName/{resourceName}", []string{http.MethodGet}},
+		{common.CoreDataServiceKey, "/api/v2/reading/start/{start}/end/{end}", []string{http.MethodGet}},
+	}
+	docs := make(map[string]Document)
+	for _, testCase := range tests {
+		t.Run(testCase.serviceKey+testCase.path, func(t *testing.T) {
+			doc, ok := docs[testCase.serviceKey]
+			if !ok {
+				var err errors.EdgeX
+				doc, err = Generate(testCase.serviceKey)
+				require.NoError(t, err)
+				docs[testCase.serviceKey] = doc
+			}
+			item, ok := doc.Paths[testCase.path]
+			require.True(t, ok, "%s is not described", testCase.path)
+			for _, method := range testCase.methods {
+				assert.NotNil(t, *item.operation(method), "%s %s is not described", method, testCase.path)
+			}
+		})
+	}
+}
+
+func TestGenerateUnknownService(t *testing.T) {
+	_, err := Generate("unknown")
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestGenerateOperation(t *testing.T) {
+	doc, err := Generate(common.CoreMetaDataServiceKey)
+	require.NoError(t, err)
+
+	add := doc.Paths[common.ApiDeviceRoute].Post
+	require.NotNil(t, add)
+	assert.Equal(t, "postDevice", add.OperationId)
+	require.NotNil(t, add.RequestBody)
+	body := add.RequestBody.Content[common.ContentTypeJSON].Schema
+	assert.Equal(t, "array", body.Type)
+	assert.Equal(t, schemasRefPrefix+"AddDeviceRequest", body.Items.Ref)
+	assert.Contains(t, add.Responses, "207")
+
+	all := doc.Paths[common.ApiAllDeviceRoute].Get
+	require.NotNil(t, all)
+	var query []string
+	for _, param := range all.Parameters {
+		query = append(query, param.Name)
+	}
+	assert.Equal(t, []string{common.Labels, common.Offset, common.Limit}, query)
+	assert.Equal(t, schemasRefPrefix+"MultiDevicesResponse", all.Responses["200"].Content[common.ContentTypeJSON].Schema.Ref)
+
+	upload := doc.Paths[common.ApiDeviceProfileUploadFileRoute].Post
+	require.NotNil(t, upload)
+	assert.Contains(t, upload.RequestBody.Content[contentTypeMultipart].Schema.Properties, "file")
+
+	device := doc.Components.Schemas["Device"]
+	require.NotNil(t, device)
+	assert.Equal(t, common.RFC3986UnreservedCharsRegexString, device.Properties["name"].Pattern)
+}
+
+func TestOperationId(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		method   string
+		expected string
+	}{
+		{"static route", common.ApiAllDeviceRoute, http.MethodGet, "getDeviceAll"},
+		{"parameter named by its segment", common.ApiDeviceByNameRoute, http.MethodDelete, "deleteDeviceByName"},
+		{"several parameters", common.ApiEventByTimeRangeRoute, http.MethodGet, "getEventByStartAndEnd"},
+		{"parameters only", common.ApiEventProfileNameDeviceNameSourceNameRoute, http.MethodPost, "postEventByProfileNameAndDeviceNameAndSourceName"},
+		{"literal between parameters", common.ApiDeviceProfileResourceByNameRoute, http.MethodDelete, "deleteDeviceprofileByNameResourceByResourceName"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			route, ok := routes.Lookup(testCase.template)
+			require.True(t, ok)
+			assert.Equal(t, testCase.expected, operationId(route, testCase.method))
+		})
+	}
+}
@@ -0,0 +1,214 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+)
+
+// DeviceServiceKey identifies the API served by every device service, the device services not sharing a service key
+const DeviceServiceKey = "device-service"
+
+const contentTypeMultipart = "multipart/form-data"
+
+// operation describes an operation of a service API by the DTOs of its request and response
+type operation struct {
+	template string
+	method   string
+	status   int
+	// request is the zero value of the request body DTO, nil when the operation takes no body
+	request interface{}
+	// response is the zero value of the response body DTO
+	response interface{}
+	// requestContent and responseContent are the content types of the bodies, JSON when not specified
+	requestContent  []string
+	responseContent []string
+	query           []string
+}
+
+var (
+	pageQuery        = []string{common.Offset, common.Limit}
+	labelsPageQuery  = []string{common.Labels, common.Offset, common.Limit}
+	servicesQuery    = []string{common.Services}
+	deviceCmdQuery   = []string{common.PushEvent, common.ReturnEvent}
+	jsonAndCBOR      = []string{common.ContentTypeJSON, common.ContentTypeCBOR}
+	multipartContent = []string{contentTypeMultipart}
+)
+
+// resourceNamesQuery is the optional body of the readings query by device name and time range, narrowing the readings
+// to the given resources
+var resourceNamesQuery = struct {
+	ResourceNames []string `json:"resourceNames"`
+}{}
+
+// commonOperations are served by every service
+var commonOperations = []operation{
+	{template: common.ApiConfigRoute, method: http.MethodGet, status: http.StatusOK, response: dtoCommon.ConfigResponse{}},
+	{template: common.ApiPingRoute, method: http.MethodGet, status: http.StatusOK, response: dtoCommon.PingResponse{}},
+	{template: common.ApiVersionRoute, method: http.MethodGet, status: http.StatusOK, response: dtoCommon.VersionResponse{}},
+	{template: common.ApiSecretRoute, method: http.MethodPost, status: http.StatusCreated, request: dtoCommon.SecretRequest{}, response: dtoCommon.BaseResponse{}},
+}
+
+// deviceCommandOperations are served by both core-command and the device services
+var deviceCommandOperations = []operation{
+	{template: common.ApiDeviceNameCommandNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.EventResponse{}, responseContent: jsonAndCBOR, query: deviceCmdQuery},
+	{template: common.ApiDeviceNameCommandNameRoute, method: http.MethodPut, status: http.StatusOK, request: map[string]interface{}{}, response: dtoCommon.BaseResponse{}, query: deviceCmdQuery},
+}
+
+// serviceOperations lists the operations of each service API besides the common ones
+var serviceOperations = map[string][]operation{
+	common.CoreDataServiceKey: {
+		{template: common.ApiEventProfileNameDeviceNameSourceNameRoute, method: http.MethodPost, status: http.StatusCreated, request: requests.AddEventRequest{}, response: dtoCommon.BaseWithIdResponse{}, requestContent: jsonAndCBOR},
+		{template: common.ApiAllEventRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiEventsResponse{}, query: pageQuery},
+		{template: common.ApiEventIdRoute, method: http.MethodGet, status: http.StatusOK, response: responses.EventResponse{}},
+		{template: common.ApiEventIdRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiEventCountRoute, method: http.MethodGet, status: http.StatusOK, response: dtoCommon.CountResponse{}},
+		{template: common.ApiEventCountByDeviceNameRoute, method: http.MethodGet, status: http.StatusOK, response: dtoCommon.CountResponse{}},
+		{template: common.ApiEventByDeviceNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiEventsResponse{}, query: pageQuery},
+		{template: common.ApiEventByDeviceNameRoute, method: http.MethodDelete, status: http.StatusAccepted, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiEventByTimeRangeRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiEventsResponse{}, query: pageQuery},
+		{template: common.ApiEventByAgeRoute, method: http.MethodDelete, status: http.StatusAccepted, response: dtoCommon.BaseResponse{}},
+
+		{template: common.ApiAllReadingRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiReadingsResponse{}, query: pageQuery},
+		{template: common.ApiReadingCountRoute, method: http.MethodGet, status: http.StatusOK, response: dtoCommon.CountResponse{}},
+		{template: common.ApiReadingCountByDeviceNameRoute, method: http.MethodGet, status: http.StatusOK, response: dtoCommon.CountResponse{}},
+		{template: common.ApiReadingByDeviceNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiReadingsResponse{}, query: pageQuery},
+		{template: common.ApiReadingByResourceNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiReadingsResponse{}, query: pageQuery},
+		{template: common.ApiReadingByTimeRangeRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiReadingsResponse{}, query: pageQuery},
+		{template: common.ApiReadingByResourceNameAndTimeRangeRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiReadingsResponse{}, query: pageQuery},
+		{template: common.ApiReadingByDeviceNameAndResourceNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiReadingsResponse{}, query: pageQuery},
+		{template: common.ApiReadingByDeviceNameAndResourceNameAndTimeRangeRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiReadingsResponse{}, query: pageQuery},
+		{template: common.ApiReadingByDeviceNameAndTimeRangeRoute, method: http.MethodGet, status: http.StatusOK, request: resourceNamesQuery, response: responses.MultiReadingsResponse{}, query: pageQuery},
+	},
+	common.CoreMetaDataServiceKey: {
+		{template: common.ApiDeviceProfileRoute, method: http.MethodPost, status: http.StatusMultiStatus, request: []requests.DeviceProfileRequest{}, response: []dtoCommon.BaseWithIdResponse{}},
+		{template: common.ApiDeviceProfileRoute, method: http.MethodPut, status: http.StatusMultiStatus, request: []requests.DeviceProfileRequest{}, response: []dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceProfileBasicInfoRoute, method: http.MethodPatch, status: http.StatusMultiStatus, request: []requests.DeviceProfileBasicInfoRequest{}, response: []dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceProfileDeviceCommandRoute, method: http.MethodPost, status: http.StatusMultiStatus, request: []requests.AddDeviceCommandRequest{}, response: []dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceProfileDeviceCommandRoute, method: http.MethodPatch, status: http.StatusMultiStatus, request: []requests.UpdateDeviceCommandRequest{}, response: []dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceProfileResourceRoute, method: http.MethodPost, status: http.StatusMultiStatus, request: []requests.AddDeviceResourceRequest{}, response: []dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceProfileResourceRoute, method: http.MethodPatch, status: http.StatusMultiStatus, request: []requests.UpdateDeviceResourceRequest{}, response: []dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceProfileUploadFileRoute, method: http.MethodPost, status: http.StatusCreated, response: dtoCommon.BaseWithIdResponse{}, requestContent: multipartContent},
+		{template: common.ApiDeviceProfileUploadFileRoute, method: http.MethodPut, status: http.StatusOK, response: dtoCommon.BaseResponse{}, requestContent: multipartContent},
+		{template: common.ApiDeviceProfileByNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.DeviceProfileResponse{}},
+		{template: common.ApiDeviceProfileByNameRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceProfileDeviceCommandByNameRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceProfileResourceByNameRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceProfileByIdRoute, method: http.MethodGet, status: http.StatusOK, response: responses.DeviceProfileResponse{}},
+		{template: common.ApiDeviceProfileByIdRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiAllDeviceProfileRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiDeviceProfilesResponse{}, query: labelsPageQuery},
+		{template: common.ApiDeviceProfileByManufacturerRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiDeviceProfilesResponse{}, query: pageQuery},
+		{template: common.ApiDeviceProfileByModelRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiDeviceProfilesResponse{}, query: pageQuery},
+		{template: common.ApiDeviceProfileByManufacturerAndModelRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiDeviceProfilesResponse{}, query: pageQuery},
+		{template: common.ApiDeviceResourceByProfileAndResourceRoute, method: http.MethodGet, status: http.StatusOK, response: responses.DeviceResourceResponse{}},
+
+		{template: common.ApiDeviceServiceRoute, method: http.MethodPost, status: http.StatusMultiStatus, request: []requests.AddDeviceServiceRequest{}, response: []dtoCommon.BaseWithIdResponse{}},
+		{template: common.ApiDeviceServiceRoute, method: http.MethodPatch, status: http.StatusMultiStatus, request: []requests.UpdateDeviceServiceRequest{}, response: []dtoCommon.BaseResponse{}},
+		{template: common.ApiAllDeviceServiceRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiDeviceServicesResponse{}, query: labelsPageQuery},
+		{template: common.ApiDeviceServiceByNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.DeviceServiceResponse{}},
+		{template: common.ApiDeviceServiceByNameRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceServiceByIdRoute, method: http.MethodGet, status: http.StatusOK, response: responses.DeviceServiceResponse{}},
+		{template: common.ApiDeviceServiceByIdRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+
+		{template: common.ApiDeviceRoute, method: http.MethodPost, status: http.StatusMultiStatus, request: []requests.AddDeviceRequest{}, response: []dtoCommon.BaseWithIdResponse{}},
+		{template: common.ApiDeviceRoute, method: http.MethodPatch, status: http.StatusMultiStatus, request: []requests.UpdateDeviceRequest{}, response: []dtoCommon.BaseResponse{}},
+		{template: common.ApiAllDeviceRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiDevicesResponse{}, query: labelsPageQuery},
+		{template: common.ApiDeviceIdExistsRoute, method: http.MethodGet, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceNameExistsRoute, method: http.MethodGet, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceByIdRoute, method: http.MethodGet, status: http.StatusOK, response: responses.DeviceResponse{}},
+		{template: common.ApiDeviceByIdRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceByNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.DeviceResponse{}},
+		{template: common.ApiDeviceByNameRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceByProfileIdRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiDevicesResponse{}, query: pageQuery},
+		{template: common.ApiDeviceByProfileNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiDevicesResponse{}, query: pageQuery},
+		{template: common.ApiDeviceByServiceIdRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiDevicesResponse{}, query: pageQuery},
+		{template: common.ApiDeviceByServiceNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiDevicesResponse{}, query: pageQuery},
+
+		{template: common.ApiProvisionWatcherRoute, method: http.MethodPost, status: http.StatusMultiStatus, request: []requests.AddProvisionWatcherRequest{}, response: []dtoCommon.BaseWithIdResponse{}},
+		{template: common.ApiProvisionWatcherRoute, method: http.MethodPatch, status: http.StatusMultiStatus, request: []requests.UpdateProvisionWatcherRequest{}, response: []dtoCommon.BaseResponse{}},
+		{template: common.ApiAllProvisionWatcherRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiProvisionWatchersResponse{}, query: labelsPageQuery},
+		{template: common.ApiProvisionWatcherByIdRoute, method: http.MethodGet, status: http.StatusOK, response: responses.ProvisionWatcherResponse{}},
+		{template: common.ApiProvisionWatcherByIdRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiProvisionWatcherByNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.ProvisionWatcherResponse{}},
+		{template: common.ApiProvisionWatcherByNameRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiProvisionWatcherByProfileNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiProvisionWatchersResponse{}, query: pageQuery},
+		{template: common.ApiProvisionWatcherByServiceNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiProvisionWatchersResponse{}, query: pageQuery},
+
+		{template: common.ApiUnitsOfMeasureRoute, method: http.MethodGet, status: http.StatusOK, response: responses.UnitsOfMeasureResponse{}},
+	},
+	common.CoreCommandServiceKey: append([]operation{
+		{template: common.ApiAllDeviceRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiDeviceCoreCommandsResponse{}, query: pageQuery},
+		{template: common.ApiDeviceByNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.DeviceCoreCommandResponse{}},
+	}, deviceCommandOperations...),
+	common.SupportNotificationsServiceKey: {
+		{template: common.ApiSubscriptionRoute, method: http.MethodPost, status: http.StatusMultiStatus, request: []requests.AddSubscriptionRequest{}, response: []dtoCommon.BaseWithIdResponse{}},
+		{template: common.ApiSubscriptionRoute, method: http.MethodPatch, status: http.StatusMultiStatus, request: []requests.UpdateSubscriptionRequest{}, response: []dtoCommon.BaseResponse{}},
+		{template: common.ApiAllSubscriptionRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiSubscriptionsResponse{}, query: pageQuery},
+		{template: common.ApiSubscriptionByNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.SubscriptionResponse{}},
+		{template: common.ApiSubscriptionByNameRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiSubscriptionByCategoryRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiSubscriptionsResponse{}, query: pageQuery},
+		{template: common.ApiSubscriptionByLabelRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiSubscriptionsResponse{}, query: pageQuery},
+		{template: common.ApiSubscriptionByReceiverRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiSubscriptionsResponse{}, query: pageQuery},
+
+		{template: common.ApiNotificationCleanupRoute, method: http.MethodDelete, status: http.StatusAccepted, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiNotificationCleanupByAgeRoute, method: http.MethodDelete, status: http.StatusAccepted, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiNotificationRoute, method: http.MethodPost, status: http.StatusMultiStatus, request: []requests.AddNotificationRequest{}, response: []dtoCommon.BaseWithIdResponse{}},
+		{template: common.ApiNotificationByTimeRangeRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiNotificationsResponse{}, query: pageQuery},
+		{template: common.ApiNotificationByAgeRoute, method: http.MethodDelete, status: http.StatusAccepted, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiNotificationByCategoryRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiNotificationsResponse{}, query: pageQuery},
+		{template: common.ApiNotificationByLabelRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiNotificationsResponse{}, query: pageQuery},
+		{template: common.ApiNotificationByIdRoute, method: http.MethodGet, status: http.StatusOK, response: responses.NotificationResponse{}},
+		{template: common.ApiNotificationByIdRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiNotificationByStatusRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiNotificationsResponse{}, query: pageQuery},
+		{template: common.ApiNotificationBySubscriptionNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiNotificationsResponse{}, query: pageQuery},
+
+		{template: common.ApiTransmissionByIdRoute, method: http.MethodGet, status: http.StatusOK, response: responses.TransmissionResponse{}},
+		{template: common.ApiTransmissionByAgeRoute, method: http.MethodDelete, status: http.StatusAccepted, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiAllTransmissionRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiTransmissionsResponse{}, query: pageQuery},
+		{template: common.ApiTransmissionBySubscriptionNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiTransmissionsResponse{}, query: pageQuery},
+		{template: common.ApiTransmissionByTimeRangeRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiTransmissionsResponse{}, query: pageQuery},
+		{template: common.ApiTransmissionByStatusRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiTransmissionsResponse{}, query: pageQuery},
+		{template: common.ApiTransmissionByNotificationIdRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiTransmissionsResponse{}, query: pageQuery},
+	},
+	common.SupportSchedulerServiceKey: {
+		{template: common.ApiIntervalRoute, method: http.MethodPost, status: http.StatusMultiStatus, request: []requests.AddIntervalRequest{}, response: []dtoCommon.BaseWithIdResponse{}},
+		{template: common.ApiIntervalRoute, method: http.MethodPatch, status: http.StatusMultiStatus, request: []requests.UpdateIntervalRequest{}, response: []dtoCommon.BaseResponse{}},
+		{template: common.ApiAllIntervalRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiIntervalsResponse{}, query: pageQuery},
+		{template: common.ApiIntervalByNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.IntervalResponse{}},
+		{template: common.ApiIntervalByNameRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiIntervalActionRoute, method: http.MethodPost, status: http.StatusMultiStatus, request: []requests.AddIntervalActionRequest{}, response: []dtoCommon.BaseWithIdResponse{}},
+		{template: common.ApiIntervalActionRoute, method: http.MethodPatch, status: http.StatusMultiStatus, request: []requests.UpdateIntervalActionRequest{}, response: []dtoCommon.BaseResponse{}},
+		{template: common.ApiAllIntervalActionRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiIntervalActionsResponse{}, query: pageQuery},
+		{template: common.ApiIntervalActionByNameRoute, method: http.MethodGet, status: http.StatusOK, response: responses.IntervalActionResponse{}},
+		{template: common.ApiIntervalActionByNameRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiIntervalActionByTargetRoute, method: http.MethodGet, status: http.StatusOK, response: responses.MultiIntervalActionsResponse{}, query: pageQuery},
+	},
+	common.SystemManagementAgentServiceKey: {
+		{template: common.ApiOperationRoute, method: http.MethodPost, status: http.StatusMultiStatus, request: []requests.OperationRequest{}, response: []dtoCommon.BaseResponse{}},
+		{template: common.ApiHealthRoute, method: http.MethodGet, status: http.StatusMultiStatus, response: []dtoCommon.BaseWithServiceNameResponse{}, query: servicesQuery},
+		{template: common.ApiMultiConfigRoute, method: http.MethodGet, status: http.StatusMultiStatus, response: []dtoCommon.BaseWithConfigResponse{}, query: servicesQuery},
+	},
+	DeviceServiceKey: append([]operation{
+		{template: common.ApiDeviceCallbackRoute, method: http.MethodPost, status: http.StatusOK, request: requests.AddDeviceRequest{}, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceCallbackRoute, method: http.MethodPut, status: http.StatusOK, request: requests.UpdateDeviceRequest{}, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceCallbackNameRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiProfileCallbackRoute, method: http.MethodPut, status: http.StatusOK, request: requests.DeviceProfileRequest{}, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiProfileCallbackNameRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiWatcherCallbackRoute, method: http.MethodPost, status: http.StatusOK, request: requests.AddProvisionWatcherRequest{}, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiWatcherCallbackRoute, method: http.MethodPut, status: http.StatusOK, request: requests.UpdateProvisionWatcherRequest{}, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiWatcherCallbackNameRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiServiceCallbackRoute, method: http.MethodPut, status: http.StatusOK, request: requests.UpdateDeviceServiceRequest{}, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiDiscoveryRoute, method: http.MethodPost, status: http.StatusAccepted, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiDiscoveryByIdRoute, method: http.MethodGet, status: http.StatusOK, response: responses.DiscoveryStatusResponse{}},
+		{template: common.ApiDiscoveryByIdRoute, method: http.MethodDelete, status: http.StatusOK, response: dtoCommon.BaseResponse{}},
+		{template: common.ApiDeviceValidationRoute, method: http.MethodPost, status: http.StatusOK, request: requests.AddDeviceRequest{}, response: dtoCommon.BaseResponse{}},
+	}, deviceCommandOperations...),
+}
@@ -0,0 +1,312 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package profiles holds the tooling of the device profiles besides their validation, i.e. the comparison of two
+// versions of a profile and the resolution of the profile templates.
+package profiles
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+)
+
+// ChangeKind tells whether a profile element was added, removed or changed
+type ChangeKind string
+
+const (
+	Added   ChangeKind = "added"
+	Removed ChangeKind = "removed"
+	Changed ChangeKind = "changed"
+)
+
+// Compatibility tells whether the devices and the consumers of their readings keep working after a change
+type Compatibility string
+
+const (
+	Compatible Compatibility = "compatible"
+	Breaking   Compatibility = "breaking"
+)
+
+// The profile elements a change applies to
+const (
+	ElementProfile        = "profile"
+	ElementDeviceResource = "deviceResource"
+	ElementDeviceCommand  = "deviceCommand"
+)
+
+// Change is a difference between two versions of a profile. Field is the changed field of the element, e.g.
+// properties.valueType, and is empty when the whole element was added or removed.
+type Change struct {
+	Kind          ChangeKind    `json:"kind"`
+	Compatibility Compatibility `json:"compatibility"`
+	Element       string        `json:"element"`
+	Name          string        `json:"name"`
+	Field         string        `json:"field,omitempty"`
+	Old           string        `json:"old,omitempty"`
+	New           string        `json:"new,omitempty"`
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s %s %s", c.Kind, c.Element, c.Name)
+	if c.Field != "" {
+		s += fmt.Sprintf(" %s: '%s' -> '%s'", c.Field, c.Old, c.New)
+	}
+	return s
+}
+
+// Diff lists the changes between two versions of a profile, Breaking telling whether one of them breaks compatibility
+type Diff struct {
+	Profile  string   `json:"profile"`
+	Breaking bool     `json:"breaking"`
+	Changes  []Change `json:"changes"`
+}
+
+// String describes the changes one per line, the breaking ones first
+func (d Diff) String() string {
+	breaking := 0
+	for _, c := range d.Changes {
+		if c.Compatibility == Breaking {
+			breaking++
+		}
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "device profile %s: %d change(s), %d breaking\n", d.Profile, len(d.Changes), breaking)
+	for _, compatibility := range []Compatibility{Breaking, Compatible} {
+		for _, c := range d.Changes {
+			if c.Compatibility == compatibility {
+				fmt.Fprintf(&b, "  %-10s %s\n", strings.ToUpper(string(c.Compatibility)), c)
+			}
+		}
+	}
+	return b.String()
+}
+
+// DiffDeviceProfiles compares two versions of a device profile. The removed resources and commands, the changes of
+// value type or scaling, and the permissions or ranges narrowed are breaking, the additions and the metadata changes are
+// compatible.
+func DiffDeviceProfiles(old dtos.DeviceProfile, new dtos.DeviceProfile) Diff {
+	d := &differ{}
+	d.diffProfile(old, new)
+	d.diffResources(old.DeviceResources, new.DeviceResources)
+	d.diffCommands(old.DeviceCommands, new.DeviceCommands)
+
+	diff := Diff{Profile: new.Name, Changes: d.changes}
+	if diff.Changes == nil {
+		diff.Changes = []Change{}
+	}
+	for _, c := range diff.Changes {
+		diff.Breaking = diff.Breaking || c.Compatibility == Breaking
+	}
+	return diff
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) add(kind ChangeKind, compatibility Compatibility, element string, name string) {
+	d.changes = append(d.changes, Change{Kind: kind, Compatibility: compatibility, Element: element, Name: name})
+}
+
+// field records the change of a field when its old and new values differ
+func (d *differ) field(compatibility Compatibility, element string, name string, field string, old string, new string) {
+	if old == new {
+		return
+	}
+	d.changes = append(d.changes, Change{Kind: Changed, Compatibility: compatibility, Element: element, Name: name, Field: field, Old: old, New: new})
+}
+
+func (d *differ) diffProfile(old dtos.DeviceProfile, new dtos.DeviceProfile) {
+	// The devices reference their profile by name
+	d.field(Breaking, ElementProfile, old.Name, "name", old.Name, new.Name)
+	d.field(Compatible, ElementProfile, new.Name, "manufacturer", old.Manufacturer, new.Manufacturer)
+	d.field(Compatible, ElementProfile, new.Name, "model", old.Model, new.Model)
+	d.field(Compatible, ElementProfile, new.Name, "description", old.Description, new.Description)
+	d.field(Compatible, ElementProfile, new.Name, "labels", strings.Join(old.Labels, ","), strings.Join(new.Labels, ","))
+}
+
+func (d *differ) diffResources(old []dtos.DeviceResource, new []dtos.DeviceResource) {
+	oldByName := make(map[string]dtos.DeviceResource, len(old))
+	for _, r := range old {
+		oldByName[r.Name] = r
+	}
+	newByName := make(map[string]dtos.DeviceResource, len(new))
+	for _, r := range new {
+		newByName[r.Name] = r
+	}
+
+	for _, r := range old {
+		if _, ok := newByName[r.Name]; !ok {
+			d.add(Removed, Breaking, ElementDeviceResource, r.Name)
+		}
+	}
+	for _, r := range new {
+		o, ok := oldByName[r.Name]
+		if !ok {
+			d.add(Added, Compatible, ElementDeviceResource, r.Name)
+			continue
+		}
+		d.diffResource(o, r)
+	}
+}
+
+func (d *differ) diffResource(old dtos.DeviceResource, new dtos.DeviceResource) {
+	name := new.Name
+	op, np := old.Properties, new.Properties
+	d.field(Compatible, ElementDeviceResource, name, "description", old.Description, new.Description)
+	d.field(hiddenCompatibility(old.IsHidden, new.IsHidden), ElementDeviceResource, name, "isHidden", strconv.FormatBool(old.IsHidden), strconv.FormatBool(new.IsHidden))
+	d.field(Breaking, ElementDeviceResource, name, "properties.valueType", normalizeValueType(op.ValueType), normalizeValueType(np.ValueType))
+	d.field(readWriteCompatibility(op.ReadWrite, np.ReadWrite), ElementDeviceResource, name, "properties.readWrite", op.ReadWrite, np.ReadWrite)
+	// The readings hold the units and the values scaled by the device service
+	d.field(Breaking, ElementDeviceResource, name, "properties.units", op.Units, np.Units)
+	d.field(minimumCompatibility(op.Minimum, np.Minimum), ElementDeviceResource, name, "properties.minimum", op.Minimum, np.Minimum)
+	d.field(maximumCompatibility(op.Maximum, np.Maximum), ElementDeviceResource, name, "properties.maximum", op.Maximum, np.Maximum)
+	d.field(Compatible, ElementDeviceResource, name, "properties.defaultValue", op.DefaultValue, np.DefaultValue)
+	d.field(Breaking, ElementDeviceResource, name, "properties.mask", op.Mask, np.Mask)
+	d.field(Breaking, ElementDeviceResource, name, "properties.shift", op.Shift, np.Shift)
+	d.field(Breaking, ElementDeviceResource, name, "properties.scale", op.Scale, np.Scale)
+	d.field(Breaking, ElementDeviceResource, name, "properties.offset", op.Offset, np.Offset)
+	d.field(Breaking, ElementDeviceResource, name, "properties.base", op.Base, np.Base)
+	d.field(Compatible, ElementDeviceResource, name, "properties.assertion", op.Assertion, np.Assertion)
+	d.field(Breaking, ElementDeviceResource, name, "properties.mediaType", op.MediaType, np.MediaType)
+	if !reflect.DeepEqual(old.Attributes, new.Attributes) {
+		d.field(Compatible, ElementDeviceResource, name, "attributes", fmt.Sprint(old.Attributes), fmt.Sprint(new.Attributes))
+	}
+	if !reflect.DeepEqual(old.Tags, new.Tags) {
+		d.field(Compatible, ElementDeviceResource, name, "tags", fmt.Sprint(old.Tags), fmt.Sprint(new.Tags))
+	}
+}
+
+func (d *differ) diffCommands(old []dtos.DeviceCommand, new []dtos.DeviceCommand) {
+	oldByName := make(map[string]dtos.DeviceCommand, len(old))
+	for _, c := range old {
+		oldByName[c.Name] = c
+	}
+	newByName := make(map[string]dtos.DeviceCommand, len(new))
+	for _, c := range new {
+		newByName[c.Name] = c
+	}
+
+	for _, c := range old {
+		if _, ok := newByName[c.Name]; !ok {
+			d.add(Removed, Breaking, ElementDeviceCommand, c.Name)
+		}
+	}
+	for _, c := range new {
+		o, ok := oldByName[c.Name]
+		if !ok {
+			d.add(Added, Compatible, ElementDeviceCommand, c.Name)
+			continue
+		}
+		d.diffCommand(o, c)
+	}
+}
+
+func (d *differ) diffCommand(old dtos.DeviceCommand, new dtos.DeviceCommand) {
+	name := new.Name
+	d.field(hiddenCompatibility(old.IsHidden, new.IsHidden), ElementDeviceCommand, name, "isHidden", strconv.FormatBool(old.IsHidden), strconv.FormatBool(new.IsHidden))
+	d.field(readWriteCompatibility(old.ReadWrite, new.ReadWrite), ElementDeviceCommand, name, "readWrite", old.ReadWrite, new.ReadWrite)
+	if !reflect.DeepEqual(old.Tags, new.Tags) {
+		d.field(Compatible, ElementDeviceCommand, name, "tags", fmt.Sprint(old.Tags), fmt.Sprint(new.Tags))
+	}
+
+	oldOps := make(map[string]dtos.ResourceOperation, len(old.ResourceOperations))
+	for _, ro := range old.ResourceOperations {
+		oldOps[ro.DeviceResource] = ro
+	}
+	newOps := make(map[string]dtos.ResourceOperation, len(new.ResourceOperations))
+	for _, ro := range new.ResourceOperations {
+		newOps[ro.DeviceResource] = ro
+	}
+	// The readings of a command are consumed by resource name, so that only the removal of a resource breaks them
+	for _, ro := range old.ResourceOperations {
+		if _, ok := newOps[ro.DeviceResource]; !ok {
+			d.field(Breaking, ElementDeviceCommand, name, "resourceOperations", ro.DeviceResource, "")
+		}
+	}
+	for _, ro := range new.ResourceOperations {
+		o, ok := oldOps[ro.DeviceResource]
+		if !ok {
+			d.field(Compatible, ElementDeviceCommand, name, "resourceOperations", "", ro.DeviceResource)
+			continue
+		}
+		field := fmt.Sprintf("resourceOperations[%s]", ro.DeviceResource)
+		d.field(Compatible, ElementDeviceCommand, name, field+".defaultValue", o.DefaultValue, ro.DefaultValue)
+		// The mappings change the values of the readings and of the settings
+		d.field(Breaking, ElementDeviceCommand, name, field+".mappings", formatMappings(o.Mappings), formatMappings(ro.Mappings))
+	}
+}
+
+// readWriteCompatibility tells whether the permission is kept, the permissions granted being compatible and the ones
+// revoked breaking
+func readWriteCompatibility(old string, new string) Compatibility {
+	for _, permission := range []string{common.ReadWrite_R, common.ReadWrite_W} {
+		if strings.Contains(old, permission) && !strings.Contains(new, permission) {
+			return Breaking
+		}
+	}
+	return Compatible
+}
+
+// hiddenCompatibility tells whether the element stays exposed by core-command, hiding it being breaking
+func hiddenCompatibility(old bool, new bool) Compatibility {
+	if !old && new {
+		return Breaking
+	}
+	return Compatible
+}
+
+// minimumCompatibility tells whether the values accepted by the old minimum are still accepted by the new one
+func minimumCompatibility(old string, new string) Compatibility {
+	if new == "" {
+		return Compatible
+	}
+	o, oldErr := strconv.ParseFloat(old, 64)
+	n, newErr := strconv.ParseFloat(new, 64)
+	if oldErr == nil && newErr == nil && n <= o {
+		return Compatible
+	}
+	return Breaking
+}
+
+// maximumCompatibility tells whether the values accepted by the old maximum are still accepted by the new one
+func maximumCompatibility(old string, new string) Compatibility {
+	if new == "" {
+		return Compatible
+	}
+	o, oldErr := strconv.ParseFloat(old, 64)
+	n, newErr := strconv.ParseFloat(new, 64)
+	if oldErr == nil && newErr == nil && n >= o {
+		return Compatible
+	}
+	return Breaking
+}
+
+// normalizeValueType ignores the case of the value types, which are normalized by core-metadata
+func normalizeValueType(valueType string) string {
+	if normalized, err := common.NormalizeValueType(valueType); err == nil {
+		return normalized
+	}
+	return valueType
+}
+
+func formatMappings(mappings map[string]string) string {
+	keys := make([]string, 0, len(mappings))
+	for k := range mappings {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = k + ":" + mappings[k]
+	}
+	return strings.Join(pairs, ",")
+}
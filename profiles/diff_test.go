@@ -0,0 +1,132 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package profiles
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+)
+
+func profileData() dtos.DeviceProfile {
+	return dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "TestProfile", Manufacturer: "TestManufacturer"},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "Temperature", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt32, ReadWrite: common.ReadWrite_RW, Minimum: "-40", Maximum: "125"}},
+			{Name: "Status", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_R}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: "Values", ReadWrite: common.ReadWrite_R, ResourceOperations: []dtos.ResourceOperation{
+				{DeviceResource: "Temperature"},
+				{DeviceResource: "Status", Mappings: map[string]string{"0": "OK"}},
+			}},
+		},
+	}
+}
+
+func TestDiffDeviceProfiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		update   func(p *dtos.DeviceProfile)
+		expected []Change
+	}{
+		{"no change", func(p *dtos.DeviceProfile) {}, []Change{}},
+		{"value type case only", func(p *dtos.DeviceProfile) { p.DeviceResources[0].Properties.ValueType = "int32" }, []Change{}},
+		{"metadata", func(p *dtos.DeviceProfile) { p.Manufacturer = "Other" },
+			[]Change{{Kind: Changed, Compatibility: Compatible, Element: ElementProfile, Name: "TestProfile", Field: "manufacturer", Old: "TestManufacturer", New: "Other"}}},
+		{"profile renamed", func(p *dtos.DeviceProfile) { p.Name = "Other" },
+			[]Change{{Kind: Changed, Compatibility: Breaking, Element: ElementProfile, Name: "TestProfile", Field: "name", Old: "TestProfile", New: "Other"}}},
+		{"resource added", func(p *dtos.DeviceProfile) {
+			p.DeviceResources = append(p.DeviceResources, dtos.DeviceResource{Name: "Humidity", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}})
+		}, []Change{{Kind: Added, Compatibility: Compatible, Element: ElementDeviceResource, Name: "Humidity"}}},
+		{"resource removed", func(p *dtos.DeviceProfile) {
+			p.DeviceResources = p.DeviceResources[:1]
+			p.DeviceCommands[0].ResourceOperations = p.DeviceCommands[0].ResourceOperations[:1]
+		}, []Change{
+			{Kind: Removed, Compatibility: Breaking, Element: ElementDeviceResource, Name: "Status"},
+			{Kind: Changed, Compatibility: Breaking, Element: ElementDeviceCommand, Name: "Values", Field: "resourceOperations", Old: "Status"},
+		}},
+		{"value type", func(p *dtos.DeviceProfile) { p.DeviceResources[0].Properties.ValueType = common.ValueTypeFloat32 },
+			[]Change{{Kind: Changed, Compatibility: Breaking, Element: ElementDeviceResource, Name: "Temperature", Field: "properties.valueType", Old: common.ValueTypeInt32, New: common.ValueTypeFloat32}}},
+		{"read write granted", func(p *dtos.DeviceProfile) { p.DeviceResources[1].Properties.ReadWrite = common.ReadWrite_RW },
+			[]Change{{Kind: Changed, Compatibility: Compatible, Element: ElementDeviceResource, Name: "Status", Field: "properties.readWrite", Old: common.ReadWrite_R, New: common.ReadWrite_RW}}},
+		{"read write revoked", func(p *dtos.DeviceProfile) { p.DeviceResources[0].Properties.ReadWrite = common.ReadWrite_R },
+			[]Change{{Kind: Changed, Compatibility: Breaking, Element: ElementDeviceResource, Name: "Temperature", Field: "properties.readWrite", Old: common.ReadWrite_RW, New: common.ReadWrite_R}}},
+		{"read write reordered", func(p *dtos.DeviceProfile) { p.DeviceResources[0].Properties.ReadWrite = common.ReadWrite_WR },
+			[]Change{{Kind: Changed, Compatibility: Compatible, Element: ElementDeviceResource, Name: "Temperature", Field: "properties.readWrite", Old: common.ReadWrite_RW, New: common.ReadWrite_WR}}},
+		{"range widened", func(p *dtos.DeviceProfile) {
+			p.DeviceResources[0].Properties.Minimum = "-50"
+			p.DeviceResources[0].Properties.Maximum = ""
+		}, []Change{
+			{Kind: Changed, Compatibility: Compatible, Element: ElementDeviceResource, Name: "Temperature", Field: "properties.minimum", Old: "-40", New: "-50"},
+			{Kind: Changed, Compatibility: Compatible, Element: ElementDeviceResource, Name: "Temperature", Field: "properties.maximum", Old: "125"},
+		}},
+		{"range narrowed", func(p *dtos.DeviceProfile) {
+			p.DeviceResources[0].Properties.Minimum = "0"
+			p.DeviceResources[1].Properties.Maximum = "10"
+		}, []Change{
+			{Kind: Changed, Compatibility: Breaking, Element: ElementDeviceResource, Name: "Temperature", Field: "properties.minimum", Old: "-40", New: "0"},
+			{Kind: Changed, Compatibility: Breaking, Element: ElementDeviceResource, Name: "Status", Field: "properties.maximum", New: "10"},
+		}},
+		{"scale", func(p *dtos.DeviceProfile) { p.DeviceResources[0].Properties.Scale = "0.1" },
+			[]Change{{Kind: Changed, Compatibility: Breaking, Element: ElementDeviceResource, Name: "Temperature", Field: "properties.scale", New: "0.1"}}},
+		{"resource hidden", func(p *dtos.DeviceProfile) { p.DeviceResources[0].IsHidden = true },
+			[]Change{{Kind: Changed, Compatibility: Breaking, Element: ElementDeviceResource, Name: "Temperature", Field: "isHidden", Old: "false", New: "true"}}},
+		{"command removed", func(p *dtos.DeviceProfile) { p.DeviceCommands = nil },
+			[]Change{{Kind: Removed, Compatibility: Breaking, Element: ElementDeviceCommand, Name: "Values"}}},
+		{"command read write granted", func(p *dtos.DeviceProfile) { p.DeviceCommands[0].ReadWrite = common.ReadWrite_RW },
+			[]Change{{Kind: Changed, Compatibility: Compatible, Element: ElementDeviceCommand, Name: "Values", Field: "readWrite", Old: common.ReadWrite_R, New: common.ReadWrite_RW}}},
+		{"mappings", func(p *dtos.DeviceProfile) {
+			p.DeviceCommands[0].ResourceOperations[1].Mappings = map[string]string{"0": "OK", "1": "Fault"}
+		},
+			[]Change{{Kind: Changed, Compatibility: Breaking, Element: ElementDeviceCommand, Name: "Values", Field: "resourceOperations[Status].mappings", Old: "0:OK", New: "0:OK,1:Fault"}}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			updated := profileData()
+			testCase.update(&updated)
+
+			diff := DiffDeviceProfiles(profileData(), updated)
+
+			assert.Equal(t, updated.Name, diff.Profile)
+			assert.Equal(t, testCase.expected, diff.Changes)
+			breaking := false
+			for _, c := range testCase.expected {
+				breaking = breaking || c.Compatibility == Breaking
+			}
+			assert.Equal(t, breaking, diff.Breaking)
+		})
+	}
+}
+
+func TestDiffOutput(t *testing.T) {
+	updated := profileData()
+	updated.DeviceResources[0].Properties.ValueType = common.ValueTypeFloat32
+	updated.DeviceCommands = append(updated.DeviceCommands, dtos.DeviceCommand{Name: "Reset", ReadWrite: common.ReadWrite_W})
+
+	diff := DiffDeviceProfiles(profileData(), updated)
+
+	expected := "device profile TestProfile: 2 change(s), 1 breaking\n" +
+		"  BREAKING   changed deviceResource Temperature properties.valueType: 'Int32' -> 'Float32'\n" +
+		"  COMPATIBLE added deviceCommand Reset\n"
+	assert.Equal(t, expected, diff.String())
+
+	data, err := json.Marshal(diff)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"profile": "TestProfile",
+		"breaking": true,
+		"changes": [
+			{"kind": "changed", "compatibility": "breaking", "element": "deviceResource", "name": "Temperature", "field": "properties.valueType", "old": "Int32", "new": "Float32"},
+			{"kind": "added", "compatibility": "compatible", "element": "deviceCommand", "name": "Reset"}
+		]
+	}`, string(data))
+}
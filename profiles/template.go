@@ -0,0 +1,280 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package profiles
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// parameterRegex matches the parameter references of the templates, e.g. ${unit}
+var parameterRegex = regexp.MustCompile(`\$\{([A-Za-z0-9_.-]+)\}`)
+
+// ProfileTemplate is a device profile which may extend a base template and reference parameters as ${name} in any of
+// its strings. Parameters holds the default values of the parameters, overriding the ones of the base template.
+type ProfileTemplate struct {
+	Extends                     string            `json:"extends,omitempty" yaml:"extends,omitempty"`
+	Parameters                  map[string]string `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	dtos.DeviceProfileBasicInfo `json:",inline" yaml:",inline"`
+	DeviceResources             []dtos.DeviceResource `json:"deviceResources,omitempty" yaml:"deviceResources,omitempty"`
+	DeviceCommands              []dtos.DeviceCommand  `json:"deviceCommands,omitempty" yaml:"deviceCommands,omitempty"`
+}
+
+// TemplateFromProfile returns the template of a plain profile, e.g. read from core-metadata, to be extended
+func TemplateFromProfile(profile dtos.DeviceProfile) ProfileTemplate {
+	return ProfileTemplate{
+		DeviceProfileBasicInfo: profile.DeviceProfileBasicInfo,
+		DeviceResources:        profile.DeviceResources,
+		DeviceCommands:         profile.DeviceCommands,
+	}
+}
+
+// Resolver flattens the profile templates into plain device profiles
+type Resolver struct {
+	templates map[string]ProfileTemplate
+}
+
+func NewResolver() *Resolver {
+	return &Resolver{templates: make(map[string]ProfileTemplate)}
+}
+
+// Add registers the template under its name, which must be unique
+func (r *Resolver) Add(template ProfileTemplate) errors.EdgeX {
+	if template.Name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "profile template name is required", nil)
+	}
+	if _, ok := r.templates[template.Name]; ok {
+		return errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("profile template %s already exists", template.Name), nil)
+	}
+	r.templates[template.Name] = template
+	return nil
+}
+
+// AddYaml registers the template read from YAML
+func (r *Resolver) AddYaml(data []byte) errors.EdgeX {
+	var template ProfileTemplate
+	if err := yaml.Unmarshal(data, &template); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal profile template YAML", err)
+	}
+	return r.Add(template)
+}
+
+// Resolve flattens the named template with its base templates and substitutes its parameters, the given parameters
+// overriding the defaults of the templates. The extending template overrides the basic info it sets, and the device
+// resources and commands of the same name, adds the other ones and the labels. The resolved profile is validated as
+// core-metadata would before being returned, its value types being normalized.
+func (r *Resolver) Resolve(name string, parameters map[string]string) (dtos.DeviceProfile, errors.EdgeX) {
+	template, err := r.flatten(name, nil)
+	if err != nil {
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	for k, v := range parameters {
+		template.Parameters[k] = v
+	}
+
+	s := substitution{parameters: template.Parameters}
+	profile := s.apply(reflect.ValueOf(dtos.DeviceProfile{
+		DeviceProfileBasicInfo: template.DeviceProfileBasicInfo,
+		DeviceResources:        template.DeviceResources,
+		DeviceCommands:         template.DeviceCommands,
+	})).Interface().(dtos.DeviceProfile)
+	if len(s.missing) > 0 {
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("profile template %s references the undefined parameters %s", name, strings.Join(s.sortedMissing(), ", ")), nil)
+	}
+
+	if err := profile.Validate(); err != nil {
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("profile template %s does not resolve to a valid device profile", name), err)
+	}
+	for i, resource := range profile.DeviceResources {
+		valueType, err := common.NormalizeValueType(resource.Properties.ValueType)
+		if err != nil {
+			return dtos.DeviceProfile{}, errors.NewCommonEdgeXWrapper(err)
+		}
+		profile.DeviceResources[i].Properties.ValueType = valueType
+	}
+	return profile, nil
+}
+
+// flatten merges the named template into its base templates, the chain of the extending templates being used to detect
+// the cycles
+func (r *Resolver) flatten(name string, chain []string) (ProfileTemplate, errors.EdgeX) {
+	for _, extending := range chain {
+		if extending == name {
+			return ProfileTemplate{}, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("profile templates extend each other: %s -> %s", strings.Join(chain, " -> "), name), nil)
+		}
+	}
+	template, ok := r.templates[name]
+	if !ok {
+		return ProfileTemplate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("profile template %s does not exist", name), nil)
+	}
+	if template.Extends == "" {
+		return merge(ProfileTemplate{}, template), nil
+	}
+
+	base, err := r.flatten(template.Extends, append(chain, name))
+	if err != nil {
+		return ProfileTemplate{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return merge(base, template), nil
+}
+
+// merge overrides the base with the extending template, the slices of the base being copied so that the registered
+// templates are left untouched
+func merge(base ProfileTemplate, extending ProfileTemplate) ProfileTemplate {
+	merged := ProfileTemplate{Parameters: make(map[string]string, len(base.Parameters)+len(extending.Parameters))}
+	for k, v := range base.Parameters {
+		merged.Parameters[k] = v
+	}
+	for k, v := range extending.Parameters {
+		merged.Parameters[k] = v
+	}
+
+	info := base.DeviceProfileBasicInfo
+	info.Name = extending.Name
+	info.Id = extending.Id
+	if extending.Manufacturer != "" {
+		info.Manufacturer = extending.Manufacturer
+	}
+	if extending.Description != "" {
+		info.Description = extending.Description
+	}
+	if extending.Model != "" {
+		info.Model = extending.Model
+	}
+	info.Labels = nil
+	for _, label := range append(append([]string(nil), base.Labels...), extending.Labels...) {
+		if !containsString(info.Labels, label) {
+			info.Labels = append(info.Labels, label)
+		}
+	}
+	merged.DeviceProfileBasicInfo = info
+
+	merged.DeviceResources = append([]dtos.DeviceResource(nil), base.DeviceResources...)
+	for _, resource := range extending.DeviceResources {
+		overridden := false
+		for i := range merged.DeviceResources {
+			if merged.DeviceResources[i].Name == resource.Name {
+				merged.DeviceResources[i] = resource
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			merged.DeviceResources = append(merged.DeviceResources, resource)
+		}
+	}
+
+	merged.DeviceCommands = append([]dtos.DeviceCommand(nil), base.DeviceCommands...)
+	for _, command := range extending.DeviceCommands {
+		overridden := false
+		for i := range merged.DeviceCommands {
+			if merged.DeviceCommands[i].Name == command.Name {
+				merged.DeviceCommands[i] = command
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			merged.DeviceCommands = append(merged.DeviceCommands, command)
+		}
+	}
+	return merged
+}
+
+// substitution replaces the parameter references of the strings, recording the parameters which are not defined
+type substitution struct {
+	parameters map[string]string
+	missing    map[string]bool
+}
+
+func (s *substitution) replace(str string) string {
+	return parameterRegex.ReplaceAllStringFunc(str, func(reference string) string {
+		name := parameterRegex.FindStringSubmatch(reference)[1]
+		value, ok := s.parameters[name]
+		if !ok {
+			if s.missing == nil {
+				s.missing = make(map[string]bool)
+			}
+			s.missing[name] = true
+			return reference
+		}
+		return value
+	})
+}
+
+// apply returns a deep copy of the value with the parameter references of its strings replaced, including the map keys
+// and the strings held by interfaces, e.g. in the resource attributes
+func (s *substitution) apply(v reflect.Value) reflect.Value {
+	c := reflect.New(v.Type()).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		c.SetString(s.replace(v.String()))
+	case reflect.Struct:
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(s.apply(v.Field(i)))
+			}
+		}
+	case reflect.Slice:
+		if !v.IsNil() {
+			c.Set(reflect.MakeSlice(v.Type(), v.Len(), v.Len()))
+			for i := 0; i < v.Len(); i++ {
+				c.Index(i).Set(s.apply(v.Index(i)))
+			}
+		}
+	case reflect.Map:
+		if !v.IsNil() {
+			c.Set(reflect.MakeMapWithSize(v.Type(), v.Len()))
+			iter := v.MapRange()
+			for iter.Next() {
+				c.SetMapIndex(s.apply(iter.Key()), s.apply(iter.Value()))
+			}
+		}
+	case reflect.Interface:
+		if !v.IsNil() {
+			c.Set(s.apply(v.Elem()))
+		}
+	case reflect.Ptr:
+		if !v.IsNil() {
+			p := reflect.New(v.Type().Elem())
+			p.Elem().Set(s.apply(v.Elem()))
+			c.Set(p)
+		}
+	default:
+		c.Set(v)
+	}
+	return c
+}
+
+func (s *substitution) sortedMissing() []string {
+	names := make([]string, 0, len(s.missing))
+	for name := range s.missing {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
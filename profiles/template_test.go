@@ -0,0 +1,164 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package profiles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+const baseTemplateYaml = `
+name: BaseSensor
+manufacturer: Acme
+model: ${model}
+labels: [sensor]
+parameters:
+  model: S100
+  unit: degC
+deviceResources:
+  - name: Temperature
+    properties:
+      valueType: int32
+      readWrite: R
+      units: ${unit}
+    attributes:
+      register: ${register}
+      size: 2
+  - name: Status
+    properties:
+      valueType: String
+      readWrite: R
+deviceCommands:
+  - name: Values
+    readWrite: R
+    resourceOperations:
+      - deviceResource: Temperature
+      - deviceResource: Status
+`
+
+const variantTemplateYaml = `
+name: Sensor-${model}
+extends: BaseSensor
+labels: [sensor, outdoor]
+parameters:
+  model: S200
+  register: "40001"
+deviceResources:
+  - name: Status
+    properties:
+      valueType: Uint8
+      readWrite: R
+  - name: Humidity
+    properties:
+      valueType: Float32
+      readWrite: R
+      units: "%"
+`
+
+func newTestResolver(t *testing.T) *Resolver {
+	r := NewResolver()
+	require.NoError(t, r.AddYaml([]byte(baseTemplateYaml)))
+	require.NoError(t, r.AddYaml([]byte(variantTemplateYaml)))
+	return r
+}
+
+func TestResolve(t *testing.T) {
+	r := newTestResolver(t)
+
+	profile, err := r.Resolve("Sensor-${model}", map[string]string{"unit": "degF"})
+	require.NoError(t, err)
+
+	expected := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "Sensor-S200", Manufacturer: "Acme", Model: "S200", Labels: []string{"sensor", "outdoor"}},
+		DeviceResources: []dtos.DeviceResource{
+			{
+				Name:       "Temperature",
+				Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt32, ReadWrite: common.ReadWrite_R, Units: "degF"},
+				Attributes: map[string]interface{}{"register": "40001", "size": 2},
+			},
+			{Name: "Status", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeUint8, ReadWrite: common.ReadWrite_R}},
+			{Name: "Humidity", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R, Units: "%"}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: "Values", ReadWrite: common.ReadWrite_R, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "Temperature"}, {DeviceResource: "Status"}}},
+		},
+	}
+	assert.Equal(t, expected, profile)
+	assert.NoError(t, profile.Validate())
+
+	// The registered templates are left untouched by the resolution
+	again, err := r.Resolve("Sensor-${model}", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "degC", again.DeviceResources[0].Properties.Units)
+	assert.Equal(t, "${unit}", r.templates["BaseSensor"].DeviceResources[0].Properties.Units)
+}
+
+func TestResolveErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		templates     []ProfileTemplate
+		resolve       string
+		parameters    map[string]string
+		expectedKind  errors.ErrKind
+		expectedError string
+	}{
+		{"unknown template", nil, "Unknown", nil, errors.KindEntityDoesNotExist, "profile template Unknown does not exist"},
+		{"unknown base",
+			[]ProfileTemplate{{Extends: "Unknown", DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "A"}}},
+			"A", nil, errors.KindEntityDoesNotExist, "profile template Unknown does not exist"},
+		{"cycle",
+			[]ProfileTemplate{
+				{Extends: "B", DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "A"}},
+				{Extends: "A", DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "B"}},
+			},
+			"A", nil, errors.KindContractInvalid, "profile templates extend each other: A -> B -> A"},
+		{"undefined parameters",
+			[]ProfileTemplate{{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "A", Model: "${model}", Description: "${vendor} ${model}"}}},
+			"A", nil, errors.KindContractInvalid, "profile template A references the undefined parameters model, vendor"},
+		{"invalid profile",
+			[]ProfileTemplate{{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "A"}, DeviceResources: []dtos.DeviceResource{
+				{Name: "R", Properties: dtos.ResourceProperties{ValueType: "${type}", ReadWrite: common.ReadWrite_R}},
+			}}},
+			"A", map[string]string{"type": "Decimal"}, errors.KindContractInvalid, "profile template A does not resolve to a valid device profile"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := NewResolver()
+			for _, template := range testCase.templates {
+				require.NoError(t, r.Add(template))
+			}
+
+			_, err := r.Resolve(testCase.resolve, testCase.parameters)
+
+			require.Error(t, err)
+			assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+			assert.Contains(t, err.Error(), testCase.expectedError)
+		})
+	}
+}
+
+func TestResolverAdd(t *testing.T) {
+	r := NewResolver()
+	require.NoError(t, r.Add(TemplateFromProfile(profileData())))
+
+	err := r.Add(TemplateFromProfile(profileData()))
+	require.Error(t, err)
+	assert.Equal(t, errors.KindDuplicateName, errors.Kind(err))
+
+	err = r.Add(ProfileTemplate{})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	profile, err := r.Resolve("TestProfile", nil)
+	require.NoError(t, err)
+	assert.Equal(t, profileData(), profile)
+}
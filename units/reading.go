@@ -0,0 +1,147 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package units
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// ConvertReading returns a copy of the simple reading with its value converted from the units of the reading to the
+// target unit, and its units set to the target unit. The value keeps the ValueType of the reading and is encoded as
+// dtos.NewSimpleReading does: float values in scientific notation and integer values rounded to the nearest integer.
+//
+// A reading without units, with a non-numeric ValueType, with an unknown unit or with units of another dimension than
+// the target unit fails with a KindContractInvalid error, and a converted value out of the range of the ValueType fails
+// with a KindOverflowError error.
+func (r *Registry) ConvertReading(reading dtos.BaseReading, targetUnit string) (dtos.BaseReading, errors.EdgeX) {
+	if reading.Units == "" {
+		return dtos.BaseReading{}, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("reading of resource %s has no units to convert from", reading.ResourceName), nil)
+	}
+	value, err := r.ConvertValue(reading.ValueType, reading.Value, reading.Units, targetUnit)
+	if err != nil {
+		return dtos.BaseReading{}, errors.NewCommonEdgeX(errors.Kind(err),
+			fmt.Sprintf("failed to convert reading of resource %s from %s to %s", reading.ResourceName, reading.Units, targetUnit), err)
+	}
+	reading.Value = value
+	reading.Units = targetUnit
+	return reading, nil
+}
+
+// ConvertValue converts the string encoded value of the ValueType from a unit to another unit of the same dimension.
+// Arrays are converted element by element. The value is returned unchanged when both units are the same.
+func (r *Registry) ConvertValue(valueType string, value string, from string, to string) (string, errors.EdgeX) {
+	fromUnit, toUnit, err := r.compatibleUnits(from, to)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	elementType := strings.TrimSuffix(valueType, "Array")
+	if !isNumericValueType(elementType) {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("values of type %s cannot be converted between units", valueType), nil)
+	}
+	if fromUnit == toUnit {
+		return value, nil
+	}
+	if elementType == valueType {
+		return convertElement(elementType, value, fromUnit, toUnit)
+	}
+
+	if len(value) < 2 || value[0] != '[' || value[len(value)-1] != ']' {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s value %s is not an array", valueType, value), nil)
+	}
+	if len(value) == 2 {
+		return value, nil
+	}
+	elements := strings.Split(value[1:len(value)-1], ", ") // trim "[" and "]"
+	for i, element := range elements {
+		converted, err := convertElement(elementType, element, fromUnit, toUnit)
+		if err != nil {
+			return "", errors.NewCommonEdgeXWrapper(err)
+		}
+		elements[i] = converted
+	}
+	return "[" + strings.Join(elements, ", ") + "]", nil
+}
+
+// ConvertReading converts the simple reading to the target unit with the DefaultRegistry
+func ConvertReading(reading dtos.BaseReading, targetUnit string) (dtos.BaseReading, errors.EdgeX) {
+	return defaultRegistry.ConvertReading(reading, targetUnit)
+}
+
+// ConvertValue converts the string encoded value of the ValueType from a unit to another with the DefaultRegistry
+func ConvertValue(valueType string, value string, from string, to string) (string, errors.EdgeX) {
+	return defaultRegistry.ConvertValue(valueType, value, from, to)
+}
+
+func isNumericValueType(valueType string) bool {
+	switch valueType {
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64,
+		common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64,
+		common.ValueTypeFloat32, common.ValueTypeFloat64:
+		return true
+	}
+	return false
+}
+
+// convertElement converts a single value of the numeric ValueType, keeping its type
+func convertElement(valueType string, value string, from Unit, to Unit) (string, errors.EdgeX) {
+	switch valueType {
+	case common.ValueTypeFloat32, common.ValueTypeFloat64:
+		bitSize := 64
+		if valueType == common.ValueTypeFloat32 {
+			bitSize = 32
+		}
+		parsed, err := strconv.ParseFloat(value, bitSize)
+		if err != nil {
+			return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the value %s does not match the %s valueType", value, valueType), err)
+		}
+		converted := to.fromBase(from.toBase(parsed))
+		if bitSize == 32 {
+			if math.Abs(converted) > math.MaxFloat32 {
+				return "", errors.NewCommonEdgeX(errors.KindOverflowError, fmt.Sprintf("the converted value of %s overflows the %s valueType", value, valueType), nil)
+			}
+			return fmt.Sprintf("%e", float32(converted)), nil
+		}
+		return fmt.Sprintf("%e", converted), nil
+	}
+
+	unsigned := strings.HasPrefix(valueType, "Uint")
+	bitSize, _ := strconv.Atoi(strings.TrimPrefix(strings.TrimPrefix(valueType, "Uint"), "Int"))
+	var parsed float64
+	var err error
+	if unsigned {
+		var unsignedValue uint64
+		unsignedValue, err = strconv.ParseUint(value, 10, bitSize)
+		parsed = float64(unsignedValue)
+	} else {
+		var signedValue int64
+		signedValue, err = strconv.ParseInt(value, 10, bitSize)
+		parsed = float64(signedValue)
+	}
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the value %s does not match the %s valueType", value, valueType), err)
+	}
+
+	converted := math.Round(to.fromBase(from.toBase(parsed)))
+	lower, upper := -math.Ldexp(1, bitSize-1), math.Ldexp(1, bitSize-1)
+	if unsigned {
+		lower, upper = 0, math.Ldexp(1, bitSize)
+	}
+	if converted < lower || converted >= upper {
+		return "", errors.NewCommonEdgeX(errors.KindOverflowError, fmt.Sprintf("the converted value of %s overflows the %s valueType", value, valueType), nil)
+	}
+	if unsigned {
+		return strconv.FormatUint(uint64(converted), 10), nil
+	}
+	return strconv.FormatInt(int64(converted), 10), nil
+}
@@ -0,0 +1,87 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package units
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+func testReading(t *testing.T, valueType string, value interface{}, units string) dtos.BaseReading {
+	reading, err := dtos.NewSimpleReading("profile", "device", "resource", valueType, value)
+	require.NoError(t, err)
+	reading.Units = units
+	return reading
+}
+
+func TestConvertReading(t *testing.T) {
+	tests := []struct {
+		name          string
+		reading       dtos.BaseReading
+		targetUnit    string
+		expectedValue string
+	}{
+		{"Float64", testReading(t, common.ValueTypeFloat64, float64(100), "C"), "F", "2.120000e+02"},
+		{"Float32", testReading(t, common.ValueTypeFloat32, float32(14.5), "psi"), "kPa", "9.997398e+01"},
+		{"Int16 rounded", testReading(t, common.ValueTypeInt16, int16(21), "C"), "F", "70"},
+		{"Int8 negative", testReading(t, common.ValueTypeInt8, int8(-40), "F"), "C", "-40"},
+		{"Uint8", testReading(t, common.ValueTypeUint8, uint8(0), "C"), "F", "32"},
+		{"Float64Array", testReading(t, common.ValueTypeFloat64Array, []float64{0, 100}, "C"), "F", "[3.200000e+01, 2.120000e+02]"},
+		{"Int32Array", testReading(t, common.ValueTypeInt32Array, []int32{100, 200}, "kPa"), "psi", "[15, 29]"},
+		{"same unit", testReading(t, common.ValueTypeFloat64, 21.5, "C"), "C", "2.150000e+01"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := ConvertReading(testCase.reading, testCase.targetUnit)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedValue, result.Value)
+			assert.Equal(t, testCase.targetUnit, result.Units)
+			assert.Equal(t, testCase.reading.ValueType, result.ValueType)
+			assert.Equal(t, testCase.reading.Id, result.Id)
+			assert.NoError(t, dtos.ValidateValue(result.ValueType, result.Value))
+		})
+	}
+}
+
+func TestConvertReadingEmptyArray(t *testing.T) {
+	result, err := ConvertReading(testReading(t, common.ValueTypeInt32Array, []int32{}, "kPa"), "psi")
+	require.NoError(t, err)
+	assert.Equal(t, "[]", result.Value)
+	assert.Equal(t, "psi", result.Units)
+}
+
+func TestConvertReadingError(t *testing.T) {
+	tests := []struct {
+		name          string
+		reading       dtos.BaseReading
+		targetUnit    string
+		expectedKind  errors.ErrKind
+		expectedError string
+	}{
+		{"no units", testReading(t, common.ValueTypeFloat64, 1.0, ""), "C", errors.KindContractInvalid, "has no units"},
+		{"unknown unit", testReading(t, common.ValueTypeFloat64, 1.0, "C"), "celsius", errors.KindContractInvalid, "unknown unit celsius"},
+		{"incompatible dimensions", testReading(t, common.ValueTypeFloat64, 1.0, "C"), "psi", errors.KindContractInvalid, "cannot be converted to unit psi"},
+		{"non-numeric value type", testReading(t, common.ValueTypeString, "hot", "C"), "F", errors.KindContractInvalid, "values of type String cannot be converted"},
+		{"invalid value", dtos.BaseReading{ValueType: common.ValueTypeInt8, Units: "C", SimpleReading: dtos.SimpleReading{Value: "abc"}}, "F", errors.KindContractInvalid, "does not match the Int8 valueType"},
+		{"overflow", testReading(t, common.ValueTypeInt8, int8(100), "C"), "F", errors.KindOverflowError, "overflows the Int8 valueType"},
+		{"negative unsigned", testReading(t, common.ValueTypeUint8, uint8(0), "F"), "C", errors.KindOverflowError, "overflows the Uint8 valueType"},
+		{"array overflow", testReading(t, common.ValueTypeUint8Array, []uint8{10, 200}, "C"), "F", errors.KindOverflowError, "overflows the Uint8 valueType"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := ConvertReading(testCase.reading, testCase.targetUnit)
+			require.Error(t, err)
+			assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+			assert.Contains(t, err.Error(), testCase.expectedError)
+		})
+	}
+}
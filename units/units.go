@@ -0,0 +1,226 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package units converts values and readings between the units of measure of a dimension, e.g. from degrees Fahrenheit
+// to degrees Celsius or from psi to kPa.
+//
+// Every unit is registered with the affine transform converting its values to the base unit of its dimension:
+// base = value*Scale + Offset. Two units are compatible when they have the same dimension.
+package units
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// Dimensions of the units registered by DefaultRegistry
+const (
+	DimensionTemperature = "temperature"
+	DimensionPressure    = "pressure"
+	DimensionLength      = "length"
+	DimensionMass        = "mass"
+	DimensionTime        = "time"
+	DimensionSpeed       = "speed"
+	DimensionVolume      = "volume"
+	DimensionEnergy      = "energy"
+	DimensionPower       = "power"
+	DimensionFrequency   = "frequency"
+	DimensionVoltage     = "voltage"
+	DimensionCurrent     = "current"
+)
+
+// Unit is a unit of measure of a dimension, whose values are converted to the base unit of the dimension as
+// value*Scale + Offset
+type Unit struct {
+	Symbol    string
+	Dimension string
+	Scale     float64
+	Offset    float64
+}
+
+// toBase converts the value to the base unit of the dimension
+func (u Unit) toBase(value float64) float64 {
+	return value*u.Scale + u.Offset
+}
+
+// fromBase converts the value from the base unit of the dimension
+func (u Unit) fromBase(value float64) float64 {
+	return (value - u.Offset) / u.Scale
+}
+
+// Registry holds the units known by the conversions, by symbol and alias. It is safe for concurrent use.
+type Registry struct {
+	mutex sync.RWMutex
+	units map[string]Unit
+}
+
+// NewRegistry creates an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{units: make(map[string]Unit)}
+}
+
+// Register adds the unit, which can also be looked up by the aliases, e.g. °C for C. A unit registered again with the
+// same symbol or alias replaces the previous one.
+func (r *Registry) Register(unit Unit, aliases ...string) errors.EdgeX {
+	if unit.Symbol == "" || unit.Dimension == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the symbol and the dimension of the unit are required", nil)
+	}
+	if unit.Scale == 0 || math.IsNaN(unit.Scale) || math.IsInf(unit.Scale, 0) || math.IsNaN(unit.Offset) || math.IsInf(unit.Offset, 0) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unit %s requires a finite non-zero scale and a finite offset", unit.Symbol), nil)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.units[unit.Symbol] = unit
+	for _, alias := range aliases {
+		r.units[alias] = unit
+	}
+	return nil
+}
+
+// Lookup returns the unit with the symbol or alias and true, or false if it is not registered
+func (r *Registry) Lookup(symbol string) (Unit, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	unit, ok := r.units[symbol]
+	return unit, ok
+}
+
+// Units returns the symbols of the units of the dimension, aliases excluded, sorted alphabetically
+func (r *Registry) Units(dimension string) []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	var symbols []string
+	for symbol, unit := range r.units {
+		if unit.Dimension == dimension && unit.Symbol == symbol {
+			symbols = append(symbols, symbol)
+		}
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// Convert converts the value from a unit to another unit of the same dimension. Unknown units and units of different
+// dimensions fail with a KindContractInvalid error.
+func (r *Registry) Convert(value float64, from string, to string) (float64, errors.EdgeX) {
+	fromUnit, toUnit, err := r.compatibleUnits(from, to)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	return toUnit.fromBase(fromUnit.toBase(value)), nil
+}
+
+// compatibleUnits looks up the units and checks that they have the same dimension
+func (r *Registry) compatibleUnits(from string, to string) (Unit, Unit, errors.EdgeX) {
+	fromUnit, ok := r.Lookup(from)
+	if !ok {
+		return Unit{}, Unit{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown unit %s", from), nil)
+	}
+	toUnit, ok := r.Lookup(to)
+	if !ok {
+		return Unit{}, Unit{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown unit %s", to), nil)
+	}
+	if fromUnit.Dimension != toUnit.Dimension {
+		return Unit{}, Unit{}, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("unit %s of dimension %s cannot be converted to unit %s of dimension %s", from, fromUnit.Dimension, to, toUnit.Dimension), nil)
+	}
+	return fromUnit, toUnit, nil
+}
+
+var defaultRegistry = newDefaultRegistry()
+
+// DefaultRegistry returns the registry used by the package level functions, which holds the common SI, metric and
+// imperial units. Units registered in it are available to all its users.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// Convert converts the value from a unit to another unit of the same dimension with the DefaultRegistry
+func Convert(value float64, from string, to string) (float64, errors.EdgeX) {
+	return defaultRegistry.Convert(value, from, to)
+}
+
+func newDefaultRegistry() *Registry {
+	registry := NewRegistry()
+	register := func(dimension string, symbol string, scale float64, offset float64, aliases ...string) {
+		_ = registry.Register(Unit{Symbol: symbol, Dimension: dimension, Scale: scale, Offset: offset}, aliases...)
+	}
+
+	// Kelvin is the base unit of temperature
+	register(DimensionTemperature, "K", 1, 0)
+	register(DimensionTemperature, "C", 1, 273.15, "°C", "degC")
+	register(DimensionTemperature, "F", 5.0/9, 459.67*5/9, "°F", "degF")
+
+	register(DimensionPressure, "Pa", 1, 0)
+	register(DimensionPressure, "hPa", 100, 0)
+	register(DimensionPressure, "kPa", 1e3, 0)
+	register(DimensionPressure, "MPa", 1e6, 0)
+	register(DimensionPressure, "mbar", 100, 0)
+	register(DimensionPressure, "bar", 1e5, 0)
+	register(DimensionPressure, "psi", 6894.757293168361, 0)
+	register(DimensionPressure, "atm", 101325, 0)
+	register(DimensionPressure, "mmHg", 133.322387415, 0)
+	register(DimensionPressure, "inHg", 3386.388640341, 0)
+
+	register(DimensionLength, "m", 1, 0)
+	register(DimensionLength, "mm", 1e-3, 0)
+	register(DimensionLength, "cm", 1e-2, 0)
+	register(DimensionLength, "km", 1e3, 0)
+	register(DimensionLength, "in", 0.0254, 0)
+	register(DimensionLength, "ft", 0.3048, 0)
+	register(DimensionLength, "yd", 0.9144, 0)
+	register(DimensionLength, "mi", 1609.344, 0)
+
+	register(DimensionMass, "kg", 1, 0)
+	register(DimensionMass, "mg", 1e-6, 0)
+	register(DimensionMass, "g", 1e-3, 0)
+	register(DimensionMass, "t", 1e3, 0)
+	register(DimensionMass, "oz", 0.028349523125, 0)
+	register(DimensionMass, "lbs", 0.45359237, 0, "lb")
+
+	register(DimensionTime, "s", 1, 0)
+	register(DimensionTime, "ms", 1e-3, 0)
+	register(DimensionTime, "min", 60, 0)
+	register(DimensionTime, "h", 3600, 0)
+	register(DimensionTime, "d", 86400, 0)
+
+	register(DimensionSpeed, "m/s", 1, 0)
+	register(DimensionSpeed, "km/h", 1/3.6, 0)
+	register(DimensionSpeed, "mph", 0.44704, 0)
+	register(DimensionSpeed, "kn", 1852.0/3600, 0)
+
+	register(DimensionVolume, "m3", 1, 0, "m³")
+	register(DimensionVolume, "L", 1e-3, 0, "l")
+	register(DimensionVolume, "mL", 1e-6, 0, "ml")
+	register(DimensionVolume, "gal", 0.003785411784, 0)
+
+	register(DimensionEnergy, "J", 1, 0)
+	register(DimensionEnergy, "kJ", 1e3, 0)
+	register(DimensionEnergy, "Wh", 3600, 0)
+	register(DimensionEnergy, "kWh", 3.6e6, 0)
+	register(DimensionEnergy, "cal", 4.184, 0)
+	register(DimensionEnergy, "kcal", 4184, 0)
+
+	register(DimensionPower, "W", 1, 0)
+	register(DimensionPower, "kW", 1e3, 0)
+	register(DimensionPower, "MW", 1e6, 0)
+	register(DimensionPower, "hp", 745.6998715822702, 0)
+
+	register(DimensionFrequency, "Hz", 1, 0)
+	register(DimensionFrequency, "kHz", 1e3, 0)
+	register(DimensionFrequency, "MHz", 1e6, 0)
+
+	register(DimensionVoltage, "V", 1, 0)
+	register(DimensionVoltage, "mV", 1e-3, 0)
+	register(DimensionVoltage, "kV", 1e3, 0)
+
+	register(DimensionCurrent, "A", 1, 0)
+	register(DimensionCurrent, "mA", 1e-3, 0)
+	return registry
+}
@@ -0,0 +1,87 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package units
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		from     string
+		to       string
+		expected float64
+	}{
+		{"C to F", 100, "C", "F", 212},
+		{"F to C", -40, "F", "C", -40},
+		{"C to K", 0, "C", "K", 273.15},
+		{"K to F", 0, "K", "F", -459.67},
+		{"alias °F to C", 32, "°F", "C", 0},
+		{"psi to kPa", 1, "psi", "kPa", 6.894757293168361},
+		{"kPa to psi", 101.325, "kPa", "psi", 14.695948775513449},
+		{"bar to kPa", 1, "bar", "kPa", 100},
+		{"same unit", 12.5, "kPa", "kPa", 12.5},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := Convert(testCase.value, testCase.from, testCase.to)
+			require.NoError(t, err)
+			assert.InDelta(t, testCase.expected, result, 1e-9)
+		})
+	}
+}
+
+func TestConvertError(t *testing.T) {
+	tests := []struct {
+		name          string
+		from          string
+		to            string
+		expectedError string
+	}{
+		{"unknown source unit", "stone", "kg", "unknown unit stone"},
+		{"unknown target unit", "kg", "stone", "unknown unit stone"},
+		{"incompatible dimensions", "C", "kPa", "unit C of dimension temperature cannot be converted to unit kPa of dimension pressure"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Convert(1, testCase.from, testCase.to)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			assert.Contains(t, err.Error(), testCase.expectedError)
+		})
+	}
+}
+
+func TestRegistryRegister(t *testing.T) {
+	registry := NewRegistry()
+	require.NoError(t, registry.Register(Unit{Symbol: "m", Dimension: DimensionLength, Scale: 1}))
+	require.NoError(t, registry.Register(Unit{Symbol: "nmi", Dimension: DimensionLength, Scale: 1852}, "NM"))
+
+	unit, ok := registry.Lookup("NM")
+	require.True(t, ok)
+	assert.Equal(t, "nmi", unit.Symbol)
+	assert.Equal(t, []string{"m", "nmi"}, registry.Units(DimensionLength))
+	result, err := registry.Convert(2, "NM", "m")
+	require.NoError(t, err)
+	assert.Equal(t, float64(3704), result)
+
+	err = registry.Register(Unit{Symbol: "x", Dimension: DimensionLength})
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	err = registry.Register(Unit{Symbol: "x", Scale: 1})
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestDefaultRegistryUnits(t *testing.T) {
+	assert.Equal(t, []string{"C", "F", "K"}, DefaultRegistry().Units(DimensionTemperature))
+	assert.Contains(t, DefaultRegistry().Units(DimensionPressure), "psi")
+}